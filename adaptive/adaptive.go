@@ -0,0 +1,319 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package adaptive is a meta-codec choosing, for each 128-integer block, the encoding
+// that compresses it the most: a constant, bit packing as done by BP32, frame of
+// reference, delta, zigzag delta or run-length encoding.
+// Each block starts with an int32 whose most significant byte is the tag of its
+// encoding, and whose lower 24 bits hold the bit widths of its four groups of 32
+// integers, 6 bits each, or the number of runs of a run-length encoded block.
+// The last block, if shorter than 128 integers, is padded with its last integer.
+// It is mostly suitable for arrays whose characteristics change along the way, such
+// as sorted regions followed by noise, as no codec has to be chosen for the whole array.
+package adaptive
+
+import (
+	"errors"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/bitpacking"
+	"github.com/dataence/encoding/cursor"
+)
+
+const (
+	DefaultBlockSize = 128
+)
+
+// Tags of the block encodings, stored in the most significant byte of the block header
+const (
+	TagConstant = iota
+	TagBP32
+	TagFOR
+	TagDelta
+	TagZigZagDelta
+	TagRLE
+)
+
+// Adaptive codec structure: this is not thread-safe (need one per thread)
+type Adaptive struct {
+	// Working area
+	block   [DefaultBlockSize]int32
+	offsets [DefaultBlockSize]int32
+}
+
+var _ encoding.Integer = (*Adaptive)(nil)
+
+func New() encoding.Integer {
+	return &Adaptive{}
+}
+
+func (this *Adaptive) Compress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("adaptive/Compress: inlength = 0. No work done.")
+	}
+
+	out[outpos.Get()] = int32(inlength)
+	tmpoutpos := outpos.Get() + 1
+
+	s := inpos.Get()
+	finalinpos := s + inlength
+
+	// Previous integer, from which the differences of the delta encodings start
+	prev := int32(0)
+
+	for ; s < finalinpos; s += DefaultBlockSize {
+		block := in[s:]
+		if finalinpos-s < DefaultBlockSize {
+			n := copy(this.block[:], in[s:finalinpos])
+			for i := n; i < DefaultBlockSize; i++ {
+				this.block[i] = this.block[n-1]
+			}
+			block = this.block[:]
+		}
+		block = block[:DefaultBlockSize]
+
+		tmpoutpos = this.encodeBlock(prev, block, out, tmpoutpos)
+		prev = block[DefaultBlockSize-1]
+	}
+
+	inpos.Add(inlength)
+	outpos.Set(tmpoutpos)
+
+	return nil
+}
+
+func (this *Adaptive) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) (err error) {
+	if inlength == 0 {
+		return errors.New("adaptive/Uncompress: inlength = 0. No work done.")
+	}
+
+	defer encoding.Recover("adaptive/Uncompress", &err)
+
+	outlength := int(in[inpos.Get()])
+	if outlength < 0 || outlength > len(out)-outpos.Get() {
+		return errors.New("adaptive/Uncompress: invalid length. Data may be corrupted.")
+	}
+
+	tmpinpos := inpos.Get() + 1
+	s := outpos.Get()
+	finaloutpos := s + outlength
+	prev := int32(0)
+
+	for ; s < finaloutpos; s += DefaultBlockSize {
+		block := out[s:]
+		if finaloutpos-s < DefaultBlockSize {
+			block = this.block[:]
+		}
+		block = block[:DefaultBlockSize]
+
+		if tmpinpos, err = this.decodeBlock(prev, in, tmpinpos, block); err != nil {
+			return errors.New("adaptive/Uncompress: " + err.Error())
+		}
+
+		if finaloutpos-s < DefaultBlockSize {
+			copy(out[s:finaloutpos], block)
+		}
+		prev = block[DefaultBlockSize-1]
+	}
+
+	inpos.Set(tmpinpos)
+	outpos.Add(outlength)
+
+	return nil
+}
+
+// encodeBlock writes the 128 integers of block with the encoding needing the fewest
+// int32s, ties being broken in favor of the fastest one, and returns the position
+// following them
+func (this *Adaptive) encodeBlock(prev int32, block []int32, out []int32, outpos int) int {
+	runs := 1
+	base := block[0]
+	for i, v := range block[1:] {
+		if v != block[i] {
+			runs++
+		}
+		if v < base {
+			base = v
+		}
+	}
+
+	if runs == 1 {
+		out[outpos] = TagConstant << 24
+		out[outpos+1] = block[0]
+		return outpos + 2
+	}
+
+	// The offsets are computed modulo 2^32, so they always fit in 32 bits
+	for i, v := range block {
+		this.offsets[i] = v - base
+	}
+
+	var bits [TagRLE][4]int32
+	var offsets [4]int32
+	for k := 0; k < 4; k++ {
+		group := block[32*k : 32*(k+1)]
+		offsets[k] = prev
+		if k > 0 {
+			offsets[k] = block[32*k-1]
+		}
+
+		bits[TagBP32][k] = encoding.MaxBits(group)
+		bits[TagFOR][k] = encoding.MaxBits(this.offsets[32*k : 32*(k+1)])
+		bits[TagDelta][k] = encoding.DeltaMaxBits(offsets[k], group)
+		bits[TagZigZagDelta][k] = encoding.ZigZagDeltaMaxBits(offsets[k], group)
+	}
+
+	// Sizes in int32s, not counting the block header
+	best, bestsize := TagBP32, DefaultBlockSize+1
+	for _, tag := range []int{TagBP32, TagFOR, TagDelta, TagZigZagDelta} {
+		size := int(bits[tag][0] + bits[tag][1] + bits[tag][2] + bits[tag][3])
+		if tag == TagFOR {
+			size += 1
+		}
+
+		if size < bestsize {
+			best, bestsize = tag, size
+		}
+	}
+
+	if runs+(runs+3)/4 < bestsize {
+		return encodeRuns(block, runs, out, outpos)
+	}
+
+	b := bits[best]
+	out[outpos] = int32(best)<<24 | b[0]<<18 | b[1]<<12 | b[2]<<6 | b[3]
+	outpos += 1
+
+	if best == TagFOR {
+		out[outpos] = base
+		outpos += 1
+	}
+
+	for k := 0; k < 4; k++ {
+		switch best {
+		case TagBP32:
+			bitpacking.FastPackWithoutMask(block, 32*k, out, outpos, int(b[k]))
+		case TagFOR:
+			bitpacking.FastPackWithoutMask(this.offsets[:], 32*k, out, outpos, int(b[k]))
+		case TagDelta:
+			bitpacking.DeltaPack(offsets[k], block, 32*k, out, outpos, int(b[k]))
+		case TagZigZagDelta:
+			bitpacking.ZigZagDeltaPack(offsets[k], block, 32*k, out, outpos, int(b[k]))
+		}
+		outpos += int(b[k])
+	}
+
+	return outpos
+}
+
+// encodeRuns writes the value of each run, followed by the lengths of the runs minus
+// one, four per int32
+func encodeRuns(block []int32, runs int, out []int32, outpos int) int {
+	out[outpos] = TagRLE<<24 | int32(runs)
+	outpos += 1
+
+	lengths := outpos + runs
+	for i := lengths; i < lengths+(runs+3)/4; i++ {
+		out[i] = 0
+	}
+
+	r, length := 0, 0
+	for i, v := range block {
+		length++
+		if i == len(block)-1 || block[i+1] != v {
+			out[outpos+r] = v
+			out[lengths+r/4] |= int32(length-1) << uint(8*(r%4))
+			r++
+			length = 0
+		}
+	}
+
+	return lengths + (runs+3)/4
+}
+
+func (this *Adaptive) decodeBlock(prev int32, in []int32, inpos int, block []int32) (int, error) {
+	header := in[inpos]
+	inpos += 1
+
+	tag := uint32(header) >> 24
+	switch tag {
+	case TagConstant:
+		v := in[inpos]
+		for i := range block {
+			block[i] = v
+		}
+		return inpos + 1, nil
+
+	case TagRLE:
+		return decodeRuns(int(header&0xFFFFFF), in, inpos, block)
+
+	case TagBP32, TagFOR, TagDelta, TagZigZagDelta:
+		// Handled below
+
+	default:
+		return inpos, errors.New("unknown block tag")
+	}
+
+	base := int32(0)
+	if tag == TagFOR {
+		base = in[inpos]
+		inpos += 1
+	}
+
+	for k := 0; k < 4; k++ {
+		b := int(header>>uint(18-6*k)) & 0x3F
+		if b > 32 {
+			return inpos, errors.New("invalid bit width")
+		}
+
+		offset := prev
+		if k > 0 {
+			offset = block[32*k-1]
+		}
+
+		switch tag {
+		case TagBP32, TagFOR:
+			bitpacking.FastUnpack(in, inpos, block, 32*k, b)
+		case TagDelta:
+			bitpacking.DeltaUnpack(offset, in, inpos, block, 32*k, b)
+		case TagZigZagDelta:
+			bitpacking.ZigZagDeltaUnpack(offset, in, inpos, block, 32*k, b)
+		}
+		inpos += b
+	}
+
+	if tag == TagFOR {
+		for i := range block {
+			block[i] += base
+		}
+	}
+
+	return inpos, nil
+}
+
+func decodeRuns(runs int, in []int32, inpos int, block []int32) (int, error) {
+	lengths := inpos + runs
+
+	i := 0
+	for r := 0; r < runs; r++ {
+		length := int(uint32(in[lengths+r/4])>>uint(8*(r%4))&0xFF) + 1
+		if i+length > len(block) {
+			return inpos, errors.New("runs longer than the block")
+		}
+
+		v := in[inpos+r]
+		for j := i; j < i+length; j++ {
+			block[j] = v
+		}
+		i += length
+	}
+
+	if i != len(block) {
+		return inpos, errors.New("runs shorter than the block")
+	}
+
+	return lengths + (runs+3)/4, nil
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package adaptive
+
+import (
+	"log"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/benchtools"
+	"github.com/dataence/encoding/bp32"
+	"github.com/dataence/encoding/composition"
+	"github.com/dataence/encoding/cursor"
+	dbp32 "github.com/dataence/encoding/delta/bp32"
+	dvb "github.com/dataence/encoding/delta/variablebyte"
+	"github.com/dataence/encoding/generators"
+	"github.com/dataence/encoding/variablebyte"
+)
+
+var (
+	data []int32
+	size int = 128000
+)
+
+func init() {
+	log.Printf("adaptive/init: generating %d int32s\n", size)
+	data = generators.GenerateClustered(size, size*2)
+	log.Printf("adaptive/init: generated %d integers for test", size)
+}
+
+func TestCodec(t *testing.T) {
+	sizes := []int{100, 128, 128 * 10, 128 * 100, 128 * 1000}
+	benchtools.TestCodec(New(), data, sizes)
+}
+
+// mixed returns an array whose regions suit each block encoding in turn
+func mixed() []int32 {
+	r := rand.New(rand.NewSource(1))
+	in := make([]int32, 0, 128*600)
+
+	for len(in) < cap(in) {
+		// Sorted identifiers
+		v := int32(r.Intn(1 << 30))
+		for k := 0; k < 128*20; k++ {
+			v += int32(r.Intn(10))
+			in = append(in, v)
+		}
+
+		// Small noise
+		for k := 0; k < 128*20; k++ {
+			in = append(in, int32(r.Intn(100)))
+		}
+
+		// Large values close to each other
+		base := int32(r.Intn(1 << 30))
+		for k := 0; k < 128*20; k++ {
+			in = append(in, base+int32(r.Intn(1000)))
+		}
+
+		// A signed random walk
+		v = 0
+		for k := 0; k < 128*20; k++ {
+			v += int32(r.Intn(21) - 10)
+			in = append(in, v)
+		}
+
+		// Long runs of status codes, and a constant
+		for k := 0; k < 128*10; k++ {
+			in = append(in, []int32{200, 200, 200, 404, 500}[k/300%5])
+		}
+		for k := 0; k < 128*10; k++ {
+			in = append(in, -1)
+		}
+	}
+
+	return in
+}
+
+func TestMixed(t *testing.T) {
+	in := mixed()
+	benchtools.TestCodec(New(), in, []int{len(in)})
+
+	_, out, err := benchtools.Compress(New(), in, len(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every encoding is used, and no global choice does better. Only the tags matter
+	// here, so the blocks are decoded without the previous integer.
+	var tags [TagRLE + 1]int
+	inpos := 1
+	for s := 0; s < len(in); s += DefaultBlockSize {
+		tag := uint32(out[inpos]) >> 24
+		tags[tag]++
+
+		inpos, err = New().(*Adaptive).decodeBlock(0, out, inpos, make([]int32, DefaultBlockSize))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for tag, n := range tags {
+		if n == 0 {
+			t.Errorf("adaptive/TestMixed: no block with tag %d", tag)
+		}
+	}
+
+	for name, codec := range map[string]encoding.Integer{
+		"bp32":       composition.New(bp32.New(), variablebyte.New()),
+		"delta bp32": composition.New(dbp32.New(), dvb.New()),
+	} {
+		_, other, err := benchtools.Compress(codec, in, len(in))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(out) >= len(other) {
+			t.Errorf("adaptive/TestMixed: %d int32s, %s %d int32s", len(out), name, len(other))
+		}
+	}
+}
+
+// TestTail checks the arrays whose last block is padded
+func TestTail(t *testing.T) {
+	for _, n := range []int{1, 2, 31, 127, 129, 300} {
+		in := make([]int32, n)
+		for i := range in {
+			in[i] = int32(i * i)
+		}
+		in[0] = math.MinInt32
+		in[n-1] = math.MaxInt32
+
+		out := make([]int32, 2*n+1024)
+		outpos := cursor.New()
+		if err := New().Compress(in, cursor.New(), n, out, outpos); err != nil {
+			t.Fatal(err)
+		}
+
+		// The integers following the array must not be written
+		recovered := make([]int32, n+1)
+		recovered[n] = 12345
+		inpos := cursor.New()
+		if err := New().Uncompress(out, inpos, outpos.Get(), recovered, cursor.New()); err != nil {
+			t.Fatal(err)
+		}
+
+		if inpos.Get() != outpos.Get() {
+			t.Fatalf("adaptive/TestTail: read %d int32s, expected %d", inpos.Get(), outpos.Get())
+		}
+		for i := range in {
+			if recovered[i] != in[i] {
+				t.Fatalf("adaptive/TestTail: integer %d of %d is %d, expected %d", i, n, recovered[i], in[i])
+			}
+		}
+		if recovered[n] != 12345 {
+			t.Fatalf("adaptive/TestTail: wrote past the %d integers", n)
+		}
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	b.StopTimer()
+	length := 128 * 1024
+	data := generators.GenerateClustered(length, 1<<24)
+	compdata := make([]int32, 2*length)
+	recov := make([]int32, length)
+	inpos := cursor.New()
+	outpos := cursor.New()
+	codec := New()
+	codec.Compress(data, inpos, len(data), compdata, outpos)
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		newinpos := cursor.New()
+		newoutpos := cursor.New()
+		codec.Uncompress(compdata, newinpos, outpos.Get()-newinpos.Get(), recov, newoutpos)
+	}
+}
@@ -0,0 +1,280 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package auto chooses the codec that best compresses an array of integers, among
+// the registered ones.
+// A sample of the array is analyzed, to rule out the codecs that do not suit it,
+// such as PEF for unsorted integers, and compressed with each of the remaining ones.
+// The whole array is then compressed with the codec that compressed the sample the
+// most, whose identifier is written first, so that decoding needs no parameter.
+package auto
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/adaptive"
+	"github.com/dataence/encoding/bp32"
+	"github.com/dataence/encoding/composition"
+	"github.com/dataence/encoding/cursor"
+	dbp32 "github.com/dataence/encoding/delta/bp32"
+	dfastpfor "github.com/dataence/encoding/delta/fastpfor"
+	dvb "github.com/dataence/encoding/delta/variablebyte"
+	"github.com/dataence/encoding/deltadelta"
+	"github.com/dataence/encoding/dictionary"
+	"github.com/dataence/encoding/fastpfor"
+	"github.com/dataence/encoding/frameofref"
+	"github.com/dataence/encoding/interpolative"
+	"github.com/dataence/encoding/pef"
+	"github.com/dataence/encoding/rle"
+	"github.com/dataence/encoding/simplepfor"
+	"github.com/dataence/encoding/variablebyte"
+	zbp32 "github.com/dataence/encoding/zigzag/bp32"
+	zfastpfor "github.com/dataence/encoding/zigzag/fastpfor"
+	"github.com/dataence/encoding/zigzag/nodelta"
+	zvb "github.com/dataence/encoding/zigzag/variablebyte"
+)
+
+// Codec is a codec that can be chosen
+type Codec struct {
+	// Identifier written in the compressed data: it must never change once data
+	// has been compressed with the codec
+	ID int32
+
+	Name string
+
+	// New returns a new instance of the codec, which must compress all the integers
+	// it is given, e.g. a composition for block based codecs
+	New func() encoding.Integer
+
+	// Accept reports whether the codec is worth trying for integers with the given
+	// statistics; nil accepts all integers
+	Accept func(stats *Stats) bool
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[int32]Codec)
+)
+
+// Register makes a codec available to be chosen, and to decode data compressed by it
+func Register(codec Codec) error {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if codec.New == nil {
+		return errors.New("auto/Register: codec " + codec.Name + " has no constructor")
+	}
+
+	if c, ok := registry[codec.ID]; ok {
+		return errors.New("auto/Register: identifier " + strconv.Itoa(int(codec.ID)) + " already used by " + c.Name)
+	}
+
+	registry[codec.ID] = codec
+
+	return nil
+}
+
+// Codecs returns the registered codecs, by identifier
+func Codecs() []Codec {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	codecs := make([]Codec, 0, len(registry))
+	for _, c := range registry {
+		codecs = append(codecs, c)
+	}
+	sort.Sort(byID(codecs))
+
+	return codecs
+}
+
+func lookup(id int32) (Codec, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	c, ok := registry[id]
+	return c, ok
+}
+
+func unsigned(stats *Stats) bool {
+	return stats.Negatives == 0
+}
+
+func signed(stats *Stats) bool {
+	return stats.Negatives > 0
+}
+
+func init() {
+	codecs := []Codec{
+		{1, "variablebyte", variablebyte.New, unsigned},
+		{2, "bp32", func() encoding.Integer { return composition.New(bp32.New(), variablebyte.New()) }, unsigned},
+		{3, "fastpfor", func() encoding.Integer { return composition.New(fastpfor.New(), variablebyte.New()) }, unsigned},
+		{4, "delta variablebyte", dvb.New, nil},
+		{5, "delta bp32", func() encoding.Integer { return composition.New(dbp32.New(), dvb.New()) }, nil},
+		{6, "delta fastpfor", func() encoding.Integer { return composition.New(dfastpfor.New(), dvb.New()) }, nil},
+		{7, "zigzag variablebyte", zvb.New, nil},
+		{8, "zigzag bp32", func() encoding.Integer { return composition.New(zbp32.New(), zvb.New()) }, nil},
+		{9, "zigzag fastpfor", func() encoding.Integer { return composition.New(zfastpfor.New(), zvb.New()) }, nil},
+		{10, "zigzag nodelta bp32", func() encoding.Integer { return composition.New(nodelta.NewBP32(), nodelta.NewVariableByte()) }, signed},
+		{11, "zigzag nodelta fastpfor", func() encoding.Integer { return composition.New(nodelta.NewFastPFOR(), nodelta.NewVariableByte()) }, signed},
+		{12, "frameofref", func() encoding.Integer { return composition.New(frameofref.New(), variablebyte.New()) }, nil},
+		{13, "delta delta", deltadelta.New, nil},
+		{14, "rle", func() encoding.Integer { return composition.New(rle.New(), variablebyte.New()) }, func(stats *Stats) bool {
+			return stats.AverageRunLength() >= 2
+		}},
+		{15, "dictionary", dictionary.New, nil},
+		{16, "simplepfor", func() encoding.Integer { return composition.New(simplepfor.New(), variablebyte.New()) }, unsigned},
+		{17, "pef", pef.New, func(stats *Stats) bool {
+			return stats.Sorted
+		}},
+		{18, "interpolative", interpolative.New, func(stats *Stats) bool {
+			return stats.StrictlyIncreasing
+		}},
+		{19, "adaptive", adaptive.New, nil},
+	}
+
+	for _, c := range codecs {
+		if err := Register(c); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Auto codec structure: this is not thread-safe (need one per thread)
+type Auto struct {
+	// Instances of the codecs used so far, by identifier
+	codecs map[int32]encoding.Integer
+
+	// Working area
+	sample []int32
+	buf    []int32
+}
+
+var _ encoding.Integer = (*Auto)(nil)
+
+func New() encoding.Integer {
+	return &Auto{
+		codecs: make(map[int32]encoding.Integer),
+		sample: make([]int32, DefaultSampleSize),
+	}
+}
+
+func (this *Auto) codec(c Codec) encoding.Integer {
+	codec, ok := this.codecs[c.ID]
+	if !ok {
+		codec = c.New()
+		this.codecs[c.ID] = codec
+	}
+
+	return codec
+}
+
+// Choose returns the codecs accepting the integers of in, from the one compressing a
+// sample of them the most to the one compressing it the least
+func (this *Auto) Choose(in []int32) ([]Codec, error) {
+	sample := Sample(in, DefaultSampleSize, this.sample)
+	stats := Analyze(sample)
+
+	if len(this.buf) < 2*len(sample)+1024 {
+		this.buf = make([]int32, 2*len(sample)+1024)
+	}
+
+	var candidates []Codec
+	var sizes []int
+	for _, c := range Codecs() {
+		if c.Accept != nil && !c.Accept(stats) {
+			continue
+		}
+
+		inpos, outpos := cursor.New(), cursor.New()
+		if err := this.codec(c).Compress(sample, inpos, len(sample), this.buf, outpos); err != nil || inpos.Get() != len(sample) {
+			continue
+		}
+
+		candidates = append(candidates, c)
+		sizes = append(sizes, outpos.Get())
+	}
+
+	if len(candidates) == 0 {
+		return nil, errors.New("auto/Choose: no codec can compress the integers")
+	}
+
+	sort.Stable(bySize{candidates, sizes})
+
+	return candidates, nil
+}
+
+func (this *Auto) Compress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("auto/Compress: inlength = 0. No work done.")
+	}
+
+	data := in[inpos.Get() : inpos.Get()+inlength]
+	candidates, err := this.Choose(data)
+	if err != nil {
+		return err
+	}
+
+	// The sample may not reveal that a codec cannot compress the whole array, e.g. if
+	// it is sorted but the array is not, so the next codec is then tried
+	for _, c := range candidates {
+		datapos, tmpoutpos := cursor.New(), cursor.New()
+		tmpoutpos.Set(outpos.Get() + 1)
+
+		if err := this.codec(c).Compress(data, datapos, inlength, out, tmpoutpos); err != nil || datapos.Get() != inlength {
+			continue
+		}
+
+		out[outpos.Get()] = c.ID
+		inpos.Add(inlength)
+		outpos.Set(tmpoutpos.Get())
+
+		return nil
+	}
+
+	return errors.New("auto/Compress: no codec can compress the integers")
+}
+
+func (this *Auto) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("auto/Uncompress: inlength = 0. No work done.")
+	}
+
+	id := in[inpos.Get()]
+	c, ok := lookup(id)
+	if !ok {
+		return errors.New("auto/Uncompress: unknown codec " + strconv.Itoa(int(id)))
+	}
+
+	inpos.Increment()
+	if inlength == 1 {
+		return errors.New("auto/Uncompress: no data after codec " + c.Name)
+	}
+
+	return this.codec(c).Uncompress(in, inpos, inlength-1, out, outpos)
+}
+
+type byID []Codec
+
+func (this byID) Len() int           { return len(this) }
+func (this byID) Less(i, j int) bool { return this[i].ID < this[j].ID }
+func (this byID) Swap(i, j int)      { this[i], this[j] = this[j], this[i] }
+
+type bySize struct {
+	codecs []Codec
+	sizes  []int
+}
+
+func (this bySize) Len() int           { return len(this.codecs) }
+func (this bySize) Less(i, j int) bool { return this.sizes[i] < this.sizes[j] }
+func (this bySize) Swap(i, j int) {
+	this.codecs[i], this.codecs[j] = this.codecs[j], this.codecs[i]
+	this.sizes[i], this.sizes[j] = this.sizes[j], this.sizes[i]
+}
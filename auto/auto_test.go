@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package auto
+
+import (
+	"log"
+	"math/rand"
+	"testing"
+
+	"github.com/dataence/encoding/benchtools"
+	"github.com/dataence/encoding/cursor"
+	"github.com/dataence/encoding/generators"
+	"github.com/dataence/encoding/variablebyte"
+)
+
+var (
+	data []int32
+	size int = 128000
+)
+
+func init() {
+	log.Printf("auto/init: generating %d int32s\n", size)
+	data = generators.GenerateClustered(size, size*2)
+	log.Printf("auto/init: generated %d integers for test", size)
+}
+
+func TestCodec(t *testing.T) {
+	sizes := []int{1, 100, 128, 128 * 10, 128 * 100, 128 * 1000}
+	benchtools.TestCodec(New(), data, sizes)
+}
+
+func choice(t *testing.T, in []int32) string {
+	out := make([]int32, 2*len(in)+1024)
+	outpos := cursor.New()
+	if err := New().Compress(in, cursor.New(), len(in), out, outpos); err != nil {
+		t.Fatal(err)
+	}
+
+	c, ok := lookup(out[0])
+	if !ok {
+		t.Fatalf("auto/choice: unknown codec %d", out[0])
+	}
+
+	recovered := make([]int32, len(in))
+	if err := New().Uncompress(out, cursor.New(), outpos.Get(), recovered, cursor.New()); err != nil {
+		t.Fatal(err)
+	}
+	for i := range in {
+		if recovered[i] != in[i] {
+			t.Fatalf("auto/choice: %s: integer %d is %d, expected %d", c.Name, i, recovered[i], in[i])
+		}
+	}
+
+	return c.Name
+}
+
+func TestChoice(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	// The clustered integers are sorted, so a delta codec wins
+	if name := choice(t, data); name != "delta bp32" && name != "delta fastpfor" && name != "pef" && name != "interpolative" {
+		t.Errorf("auto/TestChoice: sorted integers compressed with %s", name)
+	}
+
+	// Long runs of a few values
+	runs := make([]int32, 0, 100000)
+	for len(runs) < cap(runs)-1000 {
+		v := int32(r.Intn(5))
+		for k := r.Intn(1000); k > 0; k-- {
+			runs = append(runs, v)
+		}
+	}
+	if name := choice(t, runs); name != "rle" {
+		t.Errorf("auto/TestChoice: runs compressed with %s", name)
+	}
+
+	// Small signed readings around 0, where differences do not help
+	readings := make([]int32, 100000)
+	for i := range readings {
+		readings[i] = int32(r.Intn(64) - 32)
+	}
+	if name := choice(t, readings); name != "zigzag nodelta bp32" && name != "zigzag nodelta fastpfor" {
+		t.Errorf("auto/TestChoice: signed readings compressed with %s", name)
+	}
+}
+
+// TestSampleMisleading checks that a codec that cannot compress the whole array is
+// replaced by the next one, when the sample does not show it
+func TestSampleMisleading(t *testing.T) {
+	in := make([]int32, 100000)
+	for i := range in {
+		in[i] = int32(i)
+	}
+	in[DefaultSampleSize/SampleChunks+5] = 0
+
+	candidates, err := New().(*Auto).Choose(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := candidates[0].Name; name != "interpolative" && name != "pef" {
+		t.Fatalf("auto/TestSampleMisleading: sample compressed best with %s", name)
+	}
+
+	if name := choice(t, in); name == "interpolative" || name == "pef" {
+		t.Fatalf("auto/TestSampleMisleading: unsorted integers compressed with %s", name)
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	stats := Analyze([]int32{1, 1, 2, 2, 2, 7, -3})
+
+	if stats.Length != 7 || stats.Runs != 4 || stats.Negatives != 1 {
+		t.Fatalf("auto/TestAnalyze: wrong counts %+v", stats)
+	}
+	if stats.Sorted || stats.StrictlyIncreasing {
+		t.Fatalf("auto/TestAnalyze: unsorted integers reported as sorted")
+	}
+	if stats.MaxBits() != 32 || stats.Bits[1] != 2 || stats.Bits[2] != 3 || stats.Bits[3] != 1 {
+		t.Fatalf("auto/TestAnalyze: wrong bit widths %v", stats.Bits)
+	}
+
+	stats = Analyze(data[:1000])
+	if !stats.Sorted {
+		t.Fatalf("auto/TestAnalyze: sorted integers reported as unsorted")
+	}
+}
+
+func TestRegister(t *testing.T) {
+	if err := Register(Codec{ID: 1, Name: "duplicate", New: variablebyte.New}); err == nil {
+		t.Fatalf("auto/TestRegister: expected an error for a duplicate identifier")
+	}
+
+	compressed := []int32{1 << 20, 0}
+	recovered := make([]int32, 2)
+	if err := New().Uncompress(compressed, cursor.New(), len(compressed), recovered, cursor.New()); err == nil {
+		t.Fatalf("auto/TestRegister: expected an error for an unknown codec")
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	b.StopTimer()
+	length := 128 * 1024
+	data := generators.GenerateClustered(length, 1<<24)
+	compdata := make([]int32, 2*length)
+	recov := make([]int32, length)
+	inpos := cursor.New()
+	outpos := cursor.New()
+	codec := New()
+	codec.Compress(data, inpos, len(data), compdata, outpos)
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		newinpos := cursor.New()
+		newoutpos := cursor.New()
+		codec.Uncompress(compdata, newinpos, outpos.Get()-newinpos.Get(), recov, newoutpos)
+	}
+}
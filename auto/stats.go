@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package auto
+
+import (
+	"github.com/dataence/encoding"
+)
+
+const (
+	// Number of integers analyzed and compressed to choose a codec, taken as
+	// SampleChunks evenly spaced chunks so that local patterns such as runs are kept
+	DefaultSampleSize = 8192
+	SampleChunks      = 8
+)
+
+// Stats describes the integers of an array, or of a sample of it
+type Stats struct {
+	// Number of integers analyzed
+	Length int
+
+	// Whether every integer is greater than or equal to, or strictly greater than,
+	// the previous one
+	Sorted             bool
+	StrictlyIncreasing bool
+
+	// Number of negative integers
+	Negatives int
+
+	// Number of integers for each bit width, i.e. Bits[b] integers need b bits
+	Bits [33]int32
+
+	// Number of runs of equal successive integers
+	Runs int
+}
+
+// Analyze computes the statistics of all the integers of in
+func Analyze(in []int32) *Stats {
+	stats := &Stats{
+		Length:             len(in),
+		Sorted:             true,
+		StrictlyIncreasing: true,
+	}
+
+	freqs := stats.Bits[:]
+	i := 0
+	for ; i+128 <= len(in); i += 128 {
+		encoding.UnrolledLeadingBitFrequency128(in[i:i+128], freqs)
+	}
+	for ; i < len(in); i++ {
+		freqs[encoding.LeadingBitPosition(uint32(in[i]))]++
+	}
+
+	for i, v := range in {
+		if v < 0 {
+			stats.Negatives++
+		}
+
+		if i == 0 || v != in[i-1] {
+			stats.Runs++
+		}
+
+		if i > 0 {
+			if v < in[i-1] {
+				stats.Sorted = false
+			}
+			if v <= in[i-1] {
+				stats.StrictlyIncreasing = false
+			}
+		}
+	}
+
+	return stats
+}
+
+// MaxBits returns the bit width of the largest integer, negative integers needing 32 bits
+func (this *Stats) MaxBits() int {
+	for b := len(this.Bits) - 1; b > 0; b-- {
+		if this.Bits[b] != 0 {
+			return b
+		}
+	}
+
+	return 0
+}
+
+// AverageRunLength returns the average number of integers in a run of equal integers
+func (this *Stats) AverageRunLength() float64 {
+	if this.Runs == 0 {
+		return 0
+	}
+
+	return float64(this.Length) / float64(this.Runs)
+}
+
+// Sample returns the integers of in used to choose a codec: in itself if it is short
+// enough, and SampleChunks evenly spaced chunks otherwise. buf is used to hold the
+// sample if it is large enough.
+func Sample(in []int32, size int, buf []int32) []int32 {
+	if len(in) <= size {
+		return in
+	}
+
+	if cap(buf) < size {
+		buf = make([]int32, size)
+	}
+	buf = buf[:0]
+
+	chunk := size / SampleChunks
+	step := (len(in) - chunk) / (SampleChunks - 1)
+	for k := 0; k < SampleChunks; k++ {
+		buf = append(buf, in[k*step:k*step+chunk]...)
+	}
+
+	return buf
+}
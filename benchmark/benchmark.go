@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"math"
 	"os"
 	"runtime"
 	"runtime/pprof"
@@ -21,24 +22,39 @@ import (
 	"time"
 
 	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/adaptive"
+	"github.com/dataence/encoding/auto"
 	"github.com/dataence/encoding/bp32"
 	"github.com/dataence/encoding/composition"
 	"github.com/dataence/encoding/cursor"
 	dbp32 "github.com/dataence/encoding/delta/bp32"
 	dfastpfor "github.com/dataence/encoding/delta/fastpfor"
 	dvb "github.com/dataence/encoding/delta/variablebyte"
+	"github.com/dataence/encoding/deltadelta"
+	"github.com/dataence/encoding/dictionary"
+	"github.com/dataence/encoding/eliasdelta"
+	"github.com/dataence/encoding/eliasgamma"
 	"github.com/dataence/encoding/fastpfor"
+	"github.com/dataence/encoding/frameofref"
+	"github.com/dataence/encoding/interpolative"
+	"github.com/dataence/encoding/pef"
+	"github.com/dataence/encoding/rice"
+	"github.com/dataence/encoding/rle"
+	"github.com/dataence/encoding/simplepfor"
 	"github.com/dataence/encoding/variablebyte"
+	"github.com/dataence/encoding/xorfloat"
 	zbp32 "github.com/dataence/encoding/zigzag/bp32"
 	zfastpfor "github.com/dataence/encoding/zigzag/fastpfor"
+	"github.com/dataence/encoding/zigzag/nodelta"
+	zvb "github.com/dataence/encoding/zigzag/variablebyte"
 )
 
 type paramList []string
 
 var (
-	filesParam, dirsParam, codecsParam paramList
-	pprofParam                         bool
-	files                              []string
+	filesParam, dirsParam, codecsParam, floatCodecsParam paramList
+	pprofParam                                           bool
+	files                                                []string
 )
 
 func (this *paramList) String() string {
@@ -57,7 +73,8 @@ func init() {
 	flag.BoolVar(&pprofParam, "pprof", false, "Print result for individual files.")
 	flag.Var(&filesParam, "file", "The file containing one integer per line to encode. There can be multiple of this, or comma separated list.")
 	flag.Var(&dirsParam, "dir", "The directory containing a list of files with one integer per line. There can be multiple of this, or comma separated list.")
-	flag.Var(&codecsParam, "codec", "The codec to use: bp32, fastpfor, variablebyte, deltabp32, deltafastpfor, deltavariablebyte, zigzagbp32. There can be multiple of this, or comma separated list.")
+	flag.Var(&codecsParam, "codec", "The codec to use: bp32, fastpfor, variablebyte, deltabp32, deltafastpfor, deltavariablebyte, zigzagbp32, zigzagfastpfor, zigzagvariablebyte, zigzagnodeltabp32, zigzagnodeltafastpfor, pef, interpolative, frameofref, deltadelta, rle, dictionary, rice, eliasgamma, eliasdelta, simplepfor, simplepforvb, auto, adaptive. There can be multiple of this, or comma separated list.")
+	flag.Var(&floatCodecsParam, "floatcodec", "The codec to use for files containing one floating point number per line: gorilla64, chimp64, gorilla32, chimp32. There can be multiple of this, or comma separated list.")
 }
 
 func scanIntegers(s *bufio.Scanner) ([]int32, error) {
@@ -79,6 +96,24 @@ func scanIntegers(s *bufio.Scanner) ([]int32, error) {
 
 }
 
+func scanFloats(s *bufio.Scanner) ([]float64, error) {
+	result := make([]float64, 0, 1000000)
+	for s.Scan() {
+		f, err := strconv.ParseFloat(s.Text(), 64)
+		if err != nil {
+			return nil, err
+		} else {
+			result = append(result, f)
+		}
+	}
+
+	// Run the garbage collector to get rid of all the strings that's been allocated
+	// during the file read
+	runtime.GC()
+
+	return result, nil
+}
+
 func readIntegerFile(path string) ([]int32, error) {
 	file, err := os.Open(path)
 	if err != nil {
@@ -108,6 +143,35 @@ func readGzippedIntegerFile(path string) ([]int32, error) {
 	return scanIntegers(scanner)
 }
 
+func readFloatFile(path string) ([]float64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+
+	return scanFloats(scanner)
+}
+
+func readGzippedFloatFile(path string) ([]float64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	gunzip, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+
+	scanner := bufio.NewScanner(gunzip)
+
+	return scanFloats(scanner)
+}
+
 func getDirOfFiles(path string) ([]string, error) {
 	filenames := make([]string, 0, 10)
 
@@ -157,6 +221,40 @@ func loadIntegerFromFiles(files []string) ([][]int32, int, error) {
 	return data, max, nil
 }
 
+func loadFloatFromFiles(files []string) ([][]float64, int, error) {
+	max := 0
+	data := make([][]float64, 0, len(files))
+
+	for _, f := range files {
+		var (
+			res []float64
+			err error
+		)
+
+		log.Printf("Processing %s\n", f)
+
+		if strings.HasPrefix(f, "gz-") {
+			res, err = readGzippedFloatFile(strings.TrimPrefix(f, "gz-"))
+		} else if strings.HasSuffix(f, ".gz") {
+			res, err = readGzippedFloatFile(f)
+		} else {
+			res, err = readFloatFile(f)
+		}
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		data = append(data, res)
+
+		if len(res) > max {
+			max = len(res)
+		}
+	}
+
+	return data, max, nil
+}
+
 func getListOfFiles() []string {
 	files := make([]string, 0, 10)
 
@@ -192,9 +290,41 @@ func getListOfCodecs() (map[string]encoding.Integer, error) {
 		case "deltavariablebyte":
 			codecs["delta variablebyte"] = dvb.New()
 		case "zigzagbp32":
-			codecs["zigzag bp32"] = composition.New(zbp32.New(), dvb.New())
+			codecs["zigzag bp32"] = composition.New(zbp32.New(), zvb.New())
 		case "zigzagfastpfor":
-			codecs["zigzag fastpfor"] = composition.New(zfastpfor.New(), dvb.New())
+			codecs["zigzag fastpfor"] = composition.New(zfastpfor.New(), zvb.New())
+		case "zigzagvariablebyte":
+			codecs["zigzag variablebyte"] = zvb.New()
+		case "zigzagnodeltabp32":
+			codecs["zigzag nodelta bp32"] = composition.New(nodelta.NewBP32(), nodelta.NewVariableByte())
+		case "zigzagnodeltafastpfor":
+			codecs["zigzag nodelta fastpfor"] = composition.New(nodelta.NewFastPFOR(), nodelta.NewVariableByte())
+		case "pef":
+			codecs["pef"] = pef.New()
+		case "interpolative":
+			codecs["interpolative"] = interpolative.New()
+		case "frameofref":
+			codecs["frameofref"] = composition.New(frameofref.New(), variablebyte.New())
+		case "deltadelta":
+			codecs["delta delta"] = deltadelta.New()
+		case "rle":
+			codecs["rle"] = rle.New()
+		case "dictionary":
+			codecs["dictionary"] = dictionary.New()
+		case "rice":
+			codecs["rice"] = rice.New()
+		case "eliasgamma":
+			codecs["elias gamma"] = eliasgamma.New()
+		case "eliasdelta":
+			codecs["elias delta"] = eliasdelta.New()
+		case "simplepfor":
+			codecs["simplepfor"] = composition.New(simplepfor.New(), variablebyte.New())
+		case "simplepforvb":
+			codecs["simplepfor variablebyte"] = composition.New(simplepfor.NewWithExceptionCoder(simplepfor.VariableByte), variablebyte.New())
+		case "auto":
+			codecs["auto"] = auto.New()
+		case "adaptive":
+			codecs["adaptive"] = adaptive.New()
 		}
 	}
 
@@ -205,6 +335,30 @@ func getListOfCodecs() (map[string]encoding.Integer, error) {
 	return codecs, nil
 }
 
+func getListOfFloatCodecs() (map[string]encoding.Float64, map[string]encoding.Float32, error) {
+	codecs64 := make(map[string]encoding.Float64, 10)
+	codecs32 := make(map[string]encoding.Float32, 10)
+
+	for _, codec := range floatCodecsParam {
+		switch codec {
+		case "gorilla64":
+			codecs64["gorilla64"] = xorfloat.NewGorilla64()
+		case "chimp64":
+			codecs64["chimp64"] = xorfloat.NewChimp64()
+		case "gorilla32":
+			codecs32["gorilla32"] = xorfloat.NewGorilla32()
+		case "chimp32":
+			codecs32["chimp32"] = xorfloat.NewChimp32()
+		}
+	}
+
+	if len(codecs64)+len(codecs32) < 1 {
+		return nil, nil, fmt.Errorf("benchmark/getListOfFloatCodecs: No codecs defined")
+	}
+
+	return codecs64, codecs32, nil
+}
+
 func compress(codec encoding.Integer, in, out []int32, length int, prof bool) (duration int64, ret []int32, err error) {
 	inpos := cursor.New()
 	outpos := cursor.New()
@@ -294,10 +448,112 @@ func testCodecs(codecs map[string]encoding.Integer, data [][]int32, max int, out
 	return nil
 }
 
+// The float codecs are timed the same way as the integer ones, the 32-bit ones being
+// given the numbers converted to float32s.
+func testFloatCodecs(codecs64 map[string]encoding.Float64, codecs32 map[string]encoding.Float32, data [][]float64, max int, output bool) error {
+	compdata := make([]int32, 3*max+1)
+	decompdata := make([]float64, max)
+	data32 := make([]float32, max)
+	decompdata32 := make([]float32, max)
+
+	for name, codec := range codecs64 {
+		for i, in := range data {
+			k := len(in)
+			inpos, outpos := cursor.New(), cursor.New()
+
+			now := time.Now()
+			if err := codec.Compress(in, inpos, k, compdata, outpos); err != nil {
+				return err
+			}
+			dur := time.Since(now).Nanoseconds()
+
+			inpos2, outpos2 := cursor.New(), cursor.New()
+
+			now = time.Now()
+			if err := codec.Uncompress(compdata, inpos2, outpos.Get(), decompdata, outpos2); err != nil {
+				return err
+			}
+			dur2 := time.Since(now).Nanoseconds()
+
+			if output {
+				fmt.Printf("% 20s % 20s: %5.2f %5.2f %5.2f\n", files[i], name, float64(outpos.Get()*32)/float64(k), (float64(k) / (float64(dur) / 1000000000.0) / 1000000.0), (float64(k) / (float64(dur2) / 1000000000.0) / 1000000.0))
+			}
+
+			for j := 0; j < k; j++ {
+				if math.Float64bits(in[j]) != math.Float64bits(decompdata[j]) {
+					return fmt.Errorf("benchmark/testFloatCodecs: Problem recovering. index = %d, in = %v, recovered = %v, original length = %d\n", j, in[j], decompdata[j], k)
+				}
+			}
+
+			runtime.GC()
+		}
+	}
+
+	for name, codec := range codecs32 {
+		for i, in := range data {
+			k := len(in)
+			for j, v := range in {
+				data32[j] = float32(v)
+			}
+			inpos, outpos := cursor.New(), cursor.New()
+
+			now := time.Now()
+			if err := codec.Compress(data32, inpos, k, compdata, outpos); err != nil {
+				return err
+			}
+			dur := time.Since(now).Nanoseconds()
+
+			inpos2, outpos2 := cursor.New(), cursor.New()
+
+			now = time.Now()
+			if err := codec.Uncompress(compdata, inpos2, outpos.Get(), decompdata32, outpos2); err != nil {
+				return err
+			}
+			dur2 := time.Since(now).Nanoseconds()
+
+			if output {
+				fmt.Printf("% 20s % 20s: %5.2f %5.2f %5.2f\n", files[i], name, float64(outpos.Get()*32)/float64(k), (float64(k) / (float64(dur) / 1000000000.0) / 1000000.0), (float64(k) / (float64(dur2) / 1000000000.0) / 1000000.0))
+			}
+
+			for j := 0; j < k; j++ {
+				if math.Float32bits(data32[j]) != math.Float32bits(decompdata32[j]) {
+					return fmt.Errorf("benchmark/testFloatCodecs: Problem recovering. index = %d, in = %v, recovered = %v, original length = %d\n", j, data32[j], decompdata32[j], k)
+				}
+			}
+
+			runtime.GC()
+		}
+	}
+
+	return nil
+}
+
 func main() {
 	flag.Parse()
 	files = getListOfFiles()
 
+	if len(floatCodecsParam) > 0 {
+		codecs64, codecs32, err := getListOfFloatCodecs()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		data, max, err := loadFloatFromFiles(files)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, output := range []bool{false, false, true} {
+			if err := testFloatCodecs(codecs64, codecs32, data, max, output); err != nil {
+				log.Fatal(err)
+			}
+		}
+
+		if len(codecsParam) == 0 {
+			return
+		}
+	}
+
 	codecs, err := getListOfCodecs()
 	if err != nil {
 		log.Fatal(err)
@@ -49,6 +49,71 @@ func TestCodec(codec encoding.Integer, in []int32, sizes []int) {
 	}
 }
 
+// TestPrefixes compresses every prefix of in, from one integer to all of them, and checks
+// that they are recovered, which covers the lengths that do not fill a block or a word
+func TestPrefixes(codec encoding.Integer, in []int32) {
+	for k := 1; k <= len(in); k++ {
+		out := make([]int32, 4*len(in)+1024)
+		outpos := cursor.New()
+		if err := codec.Compress(in, cursor.New(), k, out, outpos); err != nil {
+			log.Fatal(err)
+		}
+
+		recov := make([]int32, k)
+		if err := codec.Uncompress(out, cursor.New(), outpos.Get(), recov, cursor.New()); err != nil {
+			log.Fatal(err)
+		}
+
+		for i := 0; i < k; i++ {
+			if recov[i] != in[i] {
+				log.Fatalf("benchtools/TestPrefixes: Problem recovering. index = %d, in = %d, recovered = %d, original length = %d\n", i, in[i], recov[i], k)
+			}
+		}
+	}
+}
+
+// TestDeltaCodec compresses in as consecutive chunks of chunk integers, each one starting
+// from the last integer of the previous one, and checks that they are recovered
+func TestDeltaCodec(codec encoding.DeltaInteger, in []int32, chunk int) {
+	n := len(in) / chunk * chunk
+	out := make([]int32, 2*n+1024)
+	inpos, outpos := cursor.New(), cursor.New()
+
+	// The chunks may not record their size, so it is kept aside
+	lengths := make([]int, 0, n/chunk)
+	for s := 0; s < n; s += chunk {
+		initoffset := int32(0)
+		if s > 0 {
+			initoffset = in[s-1]
+		}
+
+		start := outpos.Get()
+		if err := codec.CompressFrom(initoffset, in, inpos, chunk, out, outpos); err != nil {
+			log.Fatal(err)
+		}
+		lengths = append(lengths, outpos.Get()-start)
+	}
+
+	recov := make([]int32, n)
+	inpos, recovpos := cursor.New(), cursor.New()
+	for k, length := range lengths {
+		initoffset := int32(0)
+		if k > 0 {
+			initoffset = recov[k*chunk-1]
+		}
+
+		if err := codec.UncompressFrom(initoffset, out, inpos, length, recov, recovpos); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		if recov[i] != in[i] {
+			log.Fatalf("benchtools/TestDeltaCodec: Problem recovering. index = %d, in = %d, recovered = %d\n", i, in[i], recov[i])
+		}
+	}
+}
+
 func PprofCodec(codec encoding.Integer, in []int32, sizes []int) {
 	for _, k := range sizes {
 		if k > len(in) {
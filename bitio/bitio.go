@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package bitio reads and writes bit streams stored in consecutive int32s.
+// The bits are written starting with the least significant bit of each int32.
+// It is used by the bit-granular codecs, such as rice, eliasgamma or interpolative.
+package bitio
+
+import (
+	"math/bits"
+)
+
+// Writer writes bits into out, starting at the int32 at position pos
+type Writer struct {
+	out []int32
+	pos int
+	buf uint64
+	n   uint
+}
+
+func NewWriter(out []int32, pos int) *Writer {
+	return &Writer{out: out, pos: pos}
+}
+
+// Write writes the lowest nbits (at most 32) bits of v
+func (this *Writer) Write(v uint32, nbits uint) {
+	if nbits < 32 {
+		v &= 1<<nbits - 1
+	}
+
+	this.buf |= uint64(v) << this.n
+	this.n += nbits
+
+	if this.n >= 32 {
+		this.out[this.pos] = int32(uint32(this.buf))
+		this.pos++
+		this.buf >>= 32
+		this.n -= 32
+	}
+}
+
+// Write64 writes the lowest nbits (at most 64) bits of v
+func (this *Writer) Write64(v uint64, nbits uint) {
+	if nbits > 32 {
+		this.Write(uint32(v), 32)
+		this.Write(uint32(v>>32), nbits-32)
+	} else {
+		this.Write(uint32(v), nbits)
+	}
+}
+
+// WriteUnary writes q as q zero bits followed by a one bit
+func (this *Writer) WriteUnary(q uint64) {
+	for ; q >= 32; q -= 32 {
+		this.Write(0, 32)
+	}
+
+	this.Write(1<<uint(q), uint(q)+1)
+}
+
+// Flush writes the bits that do not fill a complete int32, padded with zeros
+func (this *Writer) Flush() {
+	if this.n > 0 {
+		this.out[this.pos] = int32(uint32(this.buf))
+		this.pos++
+		this.buf = 0
+		this.n = 0
+	}
+}
+
+// Pos returns the position of the next int32 to be written. It should only be
+// called after Flush.
+func (this *Writer) Pos() int {
+	return this.pos
+}
+
+// Reader reads bits written by Writer from in, starting at the int32 at position pos
+type Reader struct {
+	in  []int32
+	pos int
+	buf uint64
+	n   uint
+}
+
+func NewReader(in []int32, pos int) *Reader {
+	return &Reader{in: in, pos: pos}
+}
+
+// Read reads nbits (at most 32) bits
+func (this *Reader) Read(nbits uint) uint32 {
+	if this.n < nbits {
+		this.buf |= uint64(uint32(this.in[this.pos])) << this.n
+		this.pos++
+		this.n += 32
+	}
+
+	v := uint32(this.buf & (1<<nbits - 1))
+	this.buf >>= nbits
+	this.n -= nbits
+
+	return v
+}
+
+// Read64 reads nbits (at most 64) bits
+func (this *Reader) Read64(nbits uint) uint64 {
+	if nbits > 32 {
+		v := uint64(this.Read(32))
+		return v | uint64(this.Read(nbits-32))<<32
+	}
+
+	return uint64(this.Read(nbits))
+}
+
+// ReadUnary reads a value written by WriteUnary
+func (this *Reader) ReadUnary() uint64 {
+	q := uint64(0)
+
+	for {
+		if this.n == 0 {
+			this.buf = uint64(uint32(this.in[this.pos]))
+			this.pos++
+			this.n = 32
+		}
+
+		if this.buf == 0 {
+			q += uint64(this.n)
+			this.n = 0
+			continue
+		}
+
+		z := uint(bits.TrailingZeros64(this.buf))
+		this.buf >>= z + 1
+		this.n -= z + 1
+
+		return q + uint64(z)
+	}
+}
+
+// Pos returns the position of the int32 following the last one read
+func (this *Reader) Pos() int {
+	return this.pos
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package bitio
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	n := 10000
+
+	values := make([]uint64, n)
+	widths := make([]uint, n)
+	for i := range values {
+		widths[i] = uint(r.Intn(65))
+		values[i] = uint64(r.Int63())<<1 | uint64(r.Intn(2))
+		if widths[i] < 64 {
+			values[i] &= 1<<widths[i] - 1
+		}
+	}
+
+	out := make([]int32, 2*n+1)
+	w := NewWriter(out, 1)
+	for i, v := range values {
+		if i%3 == 0 {
+			w.WriteUnary(v & 127)
+		} else {
+			w.Write64(v, widths[i])
+		}
+	}
+	w.Flush()
+
+	rd := NewReader(out, 1)
+	for i, v := range values {
+		var got uint64
+		if i%3 == 0 {
+			got, v = rd.ReadUnary(), v&127
+		} else {
+			got = rd.Read64(widths[i])
+		}
+
+		if got != v {
+			t.Fatalf("bitio/TestRoundTrip: value %d = %d, expected %d", i, got, v)
+		}
+	}
+
+	if rd.Pos() != w.Pos() {
+		t.Fatalf("bitio/TestRoundTrip: read %d int32s, wrote %d", rd.Pos(), w.Pos())
+	}
+}
+
+func TestLSBFirst(t *testing.T) {
+	out := make([]int32, 2)
+	w := NewWriter(out, 0)
+	w.Write(1, 1)
+	w.WriteUnary(2)
+	w.Write(0xFFFFFFFF, 32)
+	w.Flush()
+
+	if out[0] != int32(-1<<4|0x9) || out[1] != 0xF {
+		t.Fatalf("bitio/TestLSBFirst: wrote %08x %08x", uint32(out[0]), uint32(out[1]))
+	}
+}
@@ -0,0 +1,261 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package bitmap implements a set of integers with the layout of Roaring bitmaps: the
+// integers are split into chunks of 2^16 integers sharing their upper 16 bits, whose
+// lower 16 bits are held by a container suited to their density, either a sorted
+// array, a bitmap or a list of runs of consecutive integers.
+// Array containers that are not accessed between two calls to CompressCold are kept
+// compressed with delta BP32, and decompressed the next time they are accessed.
+// Bitmaps are serialized with the Roaring portable format, so that they can be read
+// by the other Roaring implementations. As in Roaring, the integers are ordered as
+// unsigned integers, i.e. the negative integers come after the positive ones.
+// For details, please see
+// Daniel Lemire, Gregory Ssi-Yan-Kai and Owen Kaser, Consistently faster and smaller
+// compressed bitmaps with Roaring, Software: Practice and Experience 46 (11), 2016
+// http://arxiv.org/abs/1603.06549
+// and the format specification at https://github.com/RoaringBitmap/RoaringFormatSpec
+package bitmap
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/composition"
+	dbp32 "github.com/dataence/encoding/delta/bp32"
+	dvb "github.com/dataence/encoding/delta/variablebyte"
+	"github.com/dataence/encoding/sortedset"
+)
+
+// Bitmap is a set of integers: this is not thread-safe, even for reading, as cold
+// containers are decompressed when accessed (need one per thread)
+type Bitmap struct {
+	// Upper 16 bits of the integers of each chunk, in increasing order, and the
+	// container of each chunk
+	keys       []uint16
+	containers []container
+
+	// Codec of the cold array containers, and its working area
+	codec encoding.Integer
+	buf   []int32
+}
+
+func New() *Bitmap {
+	return &Bitmap{
+		codec: composition.New(dbp32.New(), dvb.New()),
+		buf:   make([]int32, 3*MaxArraySize+1024),
+	}
+}
+
+// FromSortedSet returns a bitmap holding the integers of set
+func FromSortedSet(set *sortedset.SortedSet) *Bitmap {
+	b := New()
+	set.Range(func(i int, v int32) bool {
+		b.Add(v)
+		return true
+	})
+
+	return b
+}
+
+// SortedSet returns a sorted set, compressed with delta BP32, holding the integers of
+// the bitmap
+func (this *Bitmap) SortedSet() (*sortedset.SortedSet, error) {
+	set := sortedset.New()
+
+	// The set orders the integers as signed ones, so the chunks of the negative
+	// integers come first, and each chunk is added in increasing order
+	first := sort.Search(len(this.keys), func(i int) bool {
+		return this.keys[i] >= 1<<15
+	})
+
+	var values []int32
+	for k := range this.keys {
+		i := (first + k) % len(this.keys)
+		high := uint32(this.keys[i]) << 16
+
+		values = values[:0]
+		this.each(i, func(low uint16) bool {
+			values = append(values, int32(high|uint32(low)))
+			return true
+		})
+
+		if err := set.Add(values...); err != nil {
+			return nil, errors.New("bitmap/SortedSet: " + err.Error())
+		}
+	}
+
+	return set, nil
+}
+
+// find returns the index of the chunk whose key is key, or the index at which to insert
+// it and false if there is no such chunk
+func (this *Bitmap) find(key uint16) (int, bool) {
+	i := sort.Search(len(this.keys), func(i int) bool {
+		return this.keys[i] >= key
+	})
+
+	return i, i < len(this.keys) && this.keys[i] == key
+}
+
+// container returns the container of chunk i, decompressing it if it is cold, and marks
+// it as accessed
+func (this *Bitmap) container(i int) container {
+	c := this.containers[i]
+
+	if a, ok := c.(*arrayContainer); ok {
+		if a.compressed != nil {
+			values, err := a.decompress(this.codec, this.buf)
+			if err != nil {
+				panic("bitmap: container " + strconv.Itoa(i) + " is corrupted: " + err.Error())
+			}
+			a.values, a.compressed = values, nil
+		}
+		a.hot = true
+	}
+
+	return c
+}
+
+// each calls f with the lower 16 bits of the integers of chunk i, in increasing order,
+// until f returns false, without decompressing it for good if it is cold
+func (this *Bitmap) each(i int, f func(low uint16) bool) bool {
+	if a, ok := this.containers[i].(*arrayContainer); ok && a.compressed != nil {
+		values, err := a.decompress(this.codec, this.buf)
+		if err != nil {
+			panic("bitmap: container " + strconv.Itoa(i) + " is corrupted: " + err.Error())
+		}
+
+		for _, v := range values {
+			if !f(v) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return this.containers[i].each(f)
+}
+
+// Add adds values to the bitmap
+func (this *Bitmap) Add(values ...int32) {
+	for _, v := range values {
+		key, low := uint16(uint32(v)>>16), uint16(v)
+
+		i, ok := this.find(key)
+		if !ok {
+			this.keys = append(this.keys, 0)
+			copy(this.keys[i+1:], this.keys[i:])
+			this.keys[i] = key
+
+			this.containers = append(this.containers, nil)
+			copy(this.containers[i+1:], this.containers[i:])
+			this.containers[i] = newArrayContainer()
+		}
+
+		this.containers[i] = this.container(i).add(low)
+	}
+}
+
+// Remove removes values from the bitmap
+func (this *Bitmap) Remove(values ...int32) {
+	for _, v := range values {
+		i, ok := this.find(uint16(uint32(v) >> 16))
+		if !ok {
+			continue
+		}
+
+		this.containers[i] = this.container(i).remove(uint16(v))
+
+		if this.containers[i].cardinality() == 0 {
+			this.keys = append(this.keys[:i], this.keys[i+1:]...)
+			this.containers = append(this.containers[:i], this.containers[i+1:]...)
+		}
+	}
+}
+
+// Contains reports whether v is in the bitmap
+func (this *Bitmap) Contains(v int32) bool {
+	i, ok := this.find(uint16(uint32(v) >> 16))
+	return ok && this.container(i).contains(uint16(v))
+}
+
+// Cardinality returns the number of integers in the bitmap
+func (this *Bitmap) Cardinality() int {
+	n := 0
+	for _, c := range this.containers {
+		n += c.cardinality()
+	}
+
+	return n
+}
+
+// Range calls f with the rank, counting from 0, and value of each integer, ordered as
+// unsigned integers, until f returns false
+func (this *Bitmap) Range(f func(i int, v int32) bool) {
+	n := 0
+	for i, key := range this.keys {
+		high := uint32(key) << 16
+		if !this.each(i, func(low uint16) bool {
+			n++
+			return f(n-1, int32(high|uint32(low)))
+		}) {
+			return
+		}
+	}
+}
+
+// RunOptimize converts the containers to run containers when they are smaller, once
+// serialized, and the run containers back when they are not
+func (this *Bitmap) RunOptimize() {
+	for i := range this.containers {
+		c := this.container(i)
+		runs := runSize(c.numRuns())
+
+		switch c := c.(type) {
+		case *runContainer:
+			size := arraySize(c.cardinality())
+			if c.cardinality() > MaxArraySize {
+				size = bitmapSize
+			}
+			if runs >= size {
+				this.containers[i] = c.convert()
+			}
+		case *arrayContainer:
+			if runs < arraySize(c.n) {
+				this.containers[i] = toRuns(c)
+			}
+		case *bitmapContainer:
+			if runs < bitmapSize {
+				this.containers[i] = toRuns(c)
+			}
+		}
+	}
+}
+
+// CompressCold compresses the array containers that were not accessed since its last
+// call, and returns how many containers it compressed
+func (this *Bitmap) CompressCold() (int, error) {
+	n := 0
+	for _, c := range this.containers {
+		a, ok := c.(*arrayContainer)
+		if !ok {
+			continue
+		}
+
+		if !a.hot && a.compressed == nil && len(a.values) > 0 {
+			if err := a.compress(this.codec, this.buf); err != nil {
+				return n, err
+			}
+			n++
+		}
+		a.hot = false
+	}
+
+	return n, nil
+}
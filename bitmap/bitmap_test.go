@@ -0,0 +1,376 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package bitmap
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/dataence/encoding/generators"
+	"github.com/dataence/encoding/sortedset"
+)
+
+type uint32s []uint32
+
+func (this uint32s) Len() int           { return len(this) }
+func (this uint32s) Less(i, j int) bool { return this[i] < this[j] }
+func (this uint32s) Swap(i, j int)      { this[i], this[j] = this[j], this[i] }
+
+// mixed returns integers filling array, bitmap and run containers, among which negative
+// ones, and the distinct integers ordered as unsigned ones
+func mixed() ([]int32, []int32) {
+	r := rand.New(rand.NewSource(1))
+
+	var values []int32
+	for i := 0; i < 1000; i++ {
+		values = append(values, r.Int31n(1<<16))
+	}
+	for i := 0; i < 20000; i++ {
+		values = append(values, 3<<16|r.Int31n(1<<16))
+	}
+	for v := int32(5<<16 + 100); v < 7<<16+200; v++ {
+		values = append(values, v)
+	}
+	for i := 0; i < 100; i++ {
+		values = append(values, -r.Int31n(1<<20))
+	}
+	values = append(values, -1<<31, 1<<31-1)
+
+	seen := make(map[int32]bool)
+	var sorted uint32s
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			sorted = append(sorted, uint32(v))
+		}
+	}
+	sort.Sort(sorted)
+
+	expected := make([]int32, len(sorted))
+	for i, v := range sorted {
+		expected[i] = int32(v)
+	}
+
+	return values, expected
+}
+
+// check verifies that b holds exactly the integers of expected, ordered as unsigned ones
+func check(t *testing.T, name string, b *Bitmap, expected []int32) {
+	if b.Cardinality() != len(expected) {
+		t.Fatalf("bitmap/%s: %d integers, expected %d", name, b.Cardinality(), len(expected))
+	}
+
+	n := 0
+	b.Range(func(i int, v int32) bool {
+		if i != n || v != expected[i] {
+			t.Fatalf("bitmap/%s: integer %d is %d, expected %d", name, i, v, expected[i])
+		}
+		n++
+		return true
+	})
+	if n != len(expected) {
+		t.Fatalf("bitmap/%s: %d integers visited, expected %d", name, n, len(expected))
+	}
+}
+
+func TestAddRemove(t *testing.T) {
+	values, expected := mixed()
+
+	b := New()
+	b.Add(values...)
+	check(t, "TestAddRemove", b, expected)
+
+	for _, v := range expected {
+		if !b.Contains(v) {
+			t.Fatalf("bitmap/TestAddRemove: %d not found", v)
+		}
+	}
+	if b.Contains(2 << 16) {
+		t.Fatalf("bitmap/TestAddRemove: %d found", 2<<16)
+	}
+
+	// Removing every other integer turns the bitmap containers back into arrays
+	var kept []int32
+	for i, v := range expected {
+		if i%2 == 0 {
+			b.Remove(v)
+		} else {
+			kept = append(kept, v)
+		}
+	}
+	check(t, "TestAddRemove", b, kept)
+
+	b.Remove(kept...)
+	check(t, "TestAddRemove", b, nil)
+	if len(b.keys) != 0 {
+		t.Fatalf("bitmap/TestAddRemove: %d empty containers left", len(b.keys))
+	}
+}
+
+func TestRunOptimize(t *testing.T) {
+	values, expected := mixed()
+
+	b := New()
+	b.Add(values...)
+	b.RunOptimize()
+	check(t, "TestRunOptimize", b, expected)
+
+	runs := 0
+	for _, c := range b.containers {
+		if _, ok := c.(*runContainer); ok {
+			runs++
+		}
+	}
+	if runs != 3 {
+		t.Fatalf("bitmap/TestRunOptimize: %d run containers, expected 3", runs)
+	}
+
+	// Changing a run container converts it back
+	b.Add(6<<16 + 1000)
+	b.Remove(6 << 16)
+	for i, v := range expected {
+		if v == 6<<16 {
+			expected = append(expected[:i], expected[i+1:]...)
+			break
+		}
+	}
+	check(t, "TestRunOptimize", b, expected)
+}
+
+func TestCompressCold(t *testing.T) {
+	values, expected := mixed()
+
+	b := New()
+	b.Add(values...)
+
+	// Every container was just accessed
+	if n, err := b.CompressCold(); err != nil || n != 0 {
+		t.Fatalf("bitmap/TestCompressCold: %d containers compressed, %v", n, err)
+	}
+
+	arrays := 0
+	for _, c := range b.containers {
+		if _, ok := c.(*arrayContainer); ok {
+			arrays++
+		}
+	}
+
+	// All the array containers but the one accessed since
+	b.Contains(expected[0])
+	if n, err := b.CompressCold(); err != nil || n != arrays-1 {
+		t.Fatalf("bitmap/TestCompressCold: %d containers compressed, expected %d, %v", n, arrays-1, err)
+	}
+	check(t, "TestCompressCold", b, expected)
+
+	var buf bytes.Buffer
+	if _, err := b.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range expected {
+		if !b.Contains(v) {
+			t.Fatalf("bitmap/TestCompressCold: %d not found", v)
+		}
+	}
+	b.Remove(expected[1])
+	b.Add(expected[1])
+	check(t, "TestCompressCold", b, expected)
+
+	r := New()
+	if _, err := r.ReadFrom(&buf); err != nil {
+		t.Fatal(err)
+	}
+	check(t, "TestCompressCold", r, expected)
+}
+
+func TestSerialize(t *testing.T) {
+	for _, optimize := range []bool{false, true} {
+		values, expected := mixed()
+
+		b := New()
+		b.Add(values...)
+		if optimize {
+			b.RunOptimize()
+		}
+
+		var buf bytes.Buffer
+		n, err := b.WriteTo(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if int(n) != buf.Len() {
+			t.Fatalf("bitmap/TestSerialize: wrote %d bytes, reported %d", buf.Len(), n)
+		}
+
+		r := New()
+		serialized := buf.Bytes()
+		if m, err := r.ReadFrom(bytes.NewReader(serialized)); err != nil || m != n {
+			t.Fatalf("bitmap/TestSerialize: read %d bytes of %d, %v", m, n, err)
+		}
+		check(t, "TestSerialize", r, expected)
+
+		// Truncated data is rejected, and leaves the bitmap unchanged
+		for _, l := range []int{0, 3, 10, len(serialized) / 2, len(serialized) - 1} {
+			if _, err := r.ReadFrom(bytes.NewReader(serialized[:l])); err == nil {
+				t.Fatalf("bitmap/TestSerialize: no error for %d bytes of %d", l, len(serialized))
+			}
+		}
+		check(t, "TestSerialize", r, expected)
+	}
+}
+
+// TestPortableFormat checks the bytes written for small bitmaps, as specified by the
+// Roaring portable format
+func TestPortableFormat(t *testing.T) {
+	b := New()
+	b.Add(1, 2, 3, 1<<16+5)
+
+	expected := "3a300000" + "02000000" + // cookie and number of containers
+		"00000200" + "01000000" + // keys and cardinalities minus one
+		"18000000" + "1e000000" + // offsets
+		"010002000300" + "0500" // arrays
+
+	var buf bytes.Buffer
+	if _, err := b.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if s := hex.EncodeToString(buf.Bytes()); s != expected {
+		t.Fatalf("bitmap/TestPortableFormat: wrote %s, expected %s", s, expected)
+	}
+
+	b = New()
+	for v := int32(1); v <= 100; v++ {
+		b.Add(v)
+	}
+	b.Add(1<<16 + 5)
+	b.RunOptimize()
+
+	expected = "3b300100" + "01" + // cookie with the number of containers, and the run container
+		"00006300" + "01000000" + // keys and cardinalities minus one, without offsets
+		"010001006300" + "0500" // one run of 100 integers from 1, and an array
+
+	buf.Reset()
+	if _, err := b.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if s := hex.EncodeToString(buf.Bytes()); s != expected {
+		t.Fatalf("bitmap/TestPortableFormat: wrote %s, expected %s", s, expected)
+	}
+}
+
+// TestRoaringFiles reads the test files of the Roaring format specification, written by
+// the Java implementation (https://github.com/RoaringBitmap/RoaringFormatSpec), and checks that the same bytes are written back, and written for
+// a bitmap built from the same integers
+func TestRoaringFiles(t *testing.T) {
+	var expected []int32
+	for v := int32(0); v < 100000; v += 1000 {
+		expected = append(expected, v)
+	}
+	for v := int32(100000); v < 200000; v++ {
+		expected = append(expected, 3*v)
+	}
+	for v := int32(700000); v < 800000; v++ {
+		expected = append(expected, v)
+	}
+
+	for _, runs := range []bool{false, true} {
+		name := "bitmapwithoutruns.bin"
+		if runs {
+			name = "bitmapwithruns.bin"
+		}
+		data, err := os.ReadFile(filepath.Join("testdata", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b := New()
+		n, err := b.ReadFrom(bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("bitmap/TestRoaringFiles: %s: %v", name, err)
+		}
+		if int(n) != len(data) {
+			t.Fatalf("bitmap/TestRoaringFiles: %s: read %d bytes of %d", name, n, len(data))
+		}
+		check(t, "TestRoaringFiles", b, expected)
+
+		var buf bytes.Buffer
+		if _, err := b.WriteTo(&buf); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(buf.Bytes(), data) {
+			t.Fatalf("bitmap/TestRoaringFiles: %s: wrote %d bytes that differ from the %d read", name, buf.Len(), len(data))
+		}
+
+		b = New()
+		b.Add(expected...)
+		if runs {
+			b.RunOptimize()
+		}
+		buf.Reset()
+		if _, err := b.WriteTo(&buf); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(buf.Bytes(), data) {
+			t.Fatalf("bitmap/TestRoaringFiles: %s: wrote %d bytes for a new bitmap that differ from the %d of the file", name, buf.Len(), len(data))
+		}
+	}
+}
+
+func TestSortedSet(t *testing.T) {
+	values, expected := mixed()
+
+	set := sortedset.New()
+	if err := set.Add(values...); err != nil {
+		t.Fatal(err)
+	}
+
+	b := FromSortedSet(set)
+	check(t, "TestSortedSet", b, expected)
+
+	recovered, err := b.SortedSet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recovered.Len() != set.Len() {
+		t.Fatalf("bitmap/TestSortedSet: %d integers, expected %d", recovered.Len(), set.Len())
+	}
+	set.Range(func(i int, v int32) bool {
+		if s := recovered.Select(i); s != v {
+			t.Fatalf("bitmap/TestSortedSet: integer %d is %d, expected %d", i, s, v)
+		}
+		return true
+	})
+}
+
+func BenchmarkContains(b *testing.B) {
+	b.StopTimer()
+	length := 128 * 1024
+	bm := New()
+	bm.Add(generators.GenerateClustered(length, 1<<24)...)
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		bm.Contains(int32(j * 7919 % (1 << 24)))
+	}
+}
+
+func BenchmarkSerialize(b *testing.B) {
+	b.StopTimer()
+	length := 128 * 1024
+	bm := New()
+	bm.Add(generators.GenerateClustered(length, 1<<24)...)
+	var buf bytes.Buffer
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		buf.Reset()
+		bm.WriteTo(&buf)
+	}
+}
@@ -0,0 +1,356 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package bitmap
+
+import (
+	"errors"
+	"math/bits"
+	"sort"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/cursor"
+)
+
+const (
+	// Largest cardinality of an array container, above which a bitmap container is smaller
+	MaxArraySize = 4096
+
+	// Number of 64-bit words of a bitmap container
+	bitmapWords = 1 << 16 / 64
+)
+
+// container holds the lower 16 bits of the integers of a chunk. The methods changing
+// it return the container to use from then on, which is of another kind when the
+// current one is no longer the most suitable.
+type container interface {
+	cardinality() int
+	contains(low uint16) bool
+	add(low uint16) container
+	remove(low uint16) container
+
+	// each calls f with each integer in increasing order, until f returns false, and
+	// reports whether all the integers were visited
+	each(f func(low uint16) bool) bool
+
+	// numRuns returns the number of runs of consecutive integers
+	numRuns() int
+}
+
+// arrayContainer holds up to MaxArraySize sorted integers. When cold, they are only kept
+// compressed with a delta codec, and decompressed the next time they are accessed.
+type arrayContainer struct {
+	values     []uint16
+	compressed []int32
+	n          int
+
+	// Whether the container was accessed since the last time the cold containers
+	// were compressed
+	hot bool
+}
+
+// bitmapContainer holds any number of integers, as one bit per possible integer
+type bitmapContainer struct {
+	words [bitmapWords]uint64
+	n     int
+}
+
+// interval is a run of length+1 consecutive integers, starting at start
+type interval struct {
+	start  uint16
+	length uint16
+}
+
+func (this interval) last() int {
+	return int(this.start) + int(this.length)
+}
+
+// runContainer holds runs of consecutive integers, in increasing order
+type runContainer struct {
+	runs []interval
+}
+
+func newArrayContainer() *arrayContainer {
+	return &arrayContainer{hot: true}
+}
+
+func (this *arrayContainer) cardinality() int {
+	return this.n
+}
+
+// search returns the index of the first integer greater than or equal to low
+func (this *arrayContainer) search(low uint16) int {
+	return sort.Search(len(this.values), func(i int) bool {
+		return this.values[i] >= low
+	})
+}
+
+func (this *arrayContainer) contains(low uint16) bool {
+	i := this.search(low)
+	return i < len(this.values) && this.values[i] == low
+}
+
+func (this *arrayContainer) add(low uint16) container {
+	if n := len(this.values); n > 0 && this.values[n-1] < low {
+		// Integers are often added in increasing order
+		if n == MaxArraySize {
+			return this.toBitmap().add(low)
+		}
+		this.values = append(this.values, low)
+		this.n++
+		return this
+	}
+
+	i := this.search(low)
+	if i < len(this.values) && this.values[i] == low {
+		return this
+	}
+
+	if len(this.values) == MaxArraySize {
+		return this.toBitmap().add(low)
+	}
+
+	this.values = append(this.values, 0)
+	copy(this.values[i+1:], this.values[i:])
+	this.values[i] = low
+	this.n++
+
+	return this
+}
+
+func (this *arrayContainer) remove(low uint16) container {
+	i := this.search(low)
+	if i < len(this.values) && this.values[i] == low {
+		this.values = append(this.values[:i], this.values[i+1:]...)
+		this.n--
+	}
+
+	return this
+}
+
+func (this *arrayContainer) each(f func(low uint16) bool) bool {
+	for _, v := range this.values {
+		if !f(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (this *arrayContainer) numRuns() int {
+	runs := 0
+	for i, v := range this.values {
+		if i == 0 || v != this.values[i-1]+1 {
+			runs++
+		}
+	}
+
+	return runs
+}
+
+func (this *arrayContainer) toBitmap() *bitmapContainer {
+	b := &bitmapContainer{}
+	for _, v := range this.values {
+		b.words[v/64] |= 1 << (v % 64)
+	}
+	b.n = len(this.values)
+
+	return b
+}
+
+// compress keeps the integers compressed with codec only
+func (this *arrayContainer) compress(codec encoding.Integer, buf []int32) error {
+	if this.compressed != nil || len(this.values) == 0 {
+		return nil
+	}
+
+	for i, v := range this.values {
+		buf[i] = int32(v)
+	}
+
+	inpos, outpos := cursor.New(), cursor.New()
+	if err := codec.Compress(buf, inpos, len(this.values), buf[len(this.values):], outpos); err != nil {
+		return err
+	}
+
+	this.compressed = make([]int32, outpos.Get())
+	copy(this.compressed, buf[len(this.values):])
+	this.values = nil
+
+	return nil
+}
+
+// decompress returns the integers of the container, decoded with codec in buf if it
+// is cold, without changing the container
+func (this *arrayContainer) decompress(codec encoding.Integer, buf []int32) ([]uint16, error) {
+	if this.compressed == nil {
+		return this.values, nil
+	}
+
+	outpos := cursor.New()
+	if err := codec.Uncompress(this.compressed, cursor.New(), len(this.compressed), buf, outpos); err != nil {
+		return nil, err
+	}
+	if outpos.Get() != this.n {
+		return nil, errors.New("wrong number of integers in a compressed array container")
+	}
+
+	values := make([]uint16, this.n)
+	for i, v := range buf[:this.n] {
+		values[i] = uint16(v)
+	}
+
+	return values, nil
+}
+
+func (this *bitmapContainer) cardinality() int {
+	return this.n
+}
+
+func (this *bitmapContainer) contains(low uint16) bool {
+	return this.words[low/64]&(1<<(low%64)) != 0
+}
+
+func (this *bitmapContainer) add(low uint16) container {
+	if !this.contains(low) {
+		this.words[low/64] |= 1 << (low % 64)
+		this.n++
+	}
+
+	return this
+}
+
+func (this *bitmapContainer) remove(low uint16) container {
+	if !this.contains(low) {
+		return this
+	}
+
+	this.words[low/64] &^= 1 << (low % 64)
+	this.n--
+
+	if this.n > MaxArraySize {
+		return this
+	}
+
+	a := newArrayContainer()
+	a.values = make([]uint16, 0, this.n)
+	this.each(func(low uint16) bool {
+		a.values = append(a.values, low)
+		return true
+	})
+	a.n = this.n
+
+	return a
+}
+
+func (this *bitmapContainer) each(f func(low uint16) bool) bool {
+	for k, w := range this.words {
+		for w != 0 {
+			if !f(uint16(64*k + bits.TrailingZeros64(w))) {
+				return false
+			}
+			w &= w - 1
+		}
+	}
+
+	return true
+}
+
+func (this *bitmapContainer) numRuns() int {
+	runs := 0
+	for k, w := range this.words {
+		// A run starts at each bit set whose previous bit is not
+		prev := uint64(0)
+		if k > 0 {
+			prev = this.words[k-1] >> 63
+		}
+		runs += bits.OnesCount64(w &^ (w<<1 | prev))
+	}
+
+	return runs
+}
+
+func (this *runContainer) cardinality() int {
+	n := 0
+	for _, r := range this.runs {
+		n += int(r.length) + 1
+	}
+
+	return n
+}
+
+func (this *runContainer) contains(low uint16) bool {
+	i := sort.Search(len(this.runs), func(i int) bool {
+		return this.runs[i].last() >= int(low)
+	})
+
+	return i < len(this.runs) && this.runs[i].start <= low
+}
+
+// convert returns the array or bitmap container holding the same integers
+func (this *runContainer) convert() container {
+	var c container = newArrayContainer()
+	if this.cardinality() > MaxArraySize {
+		c = &bitmapContainer{}
+	}
+
+	this.each(func(low uint16) bool {
+		c = c.add(low)
+		return true
+	})
+
+	return c
+}
+
+// Run containers are only made by RunOptimize, so they are converted to be changed
+func (this *runContainer) add(low uint16) container {
+	if this.contains(low) {
+		return this
+	}
+
+	return this.convert().add(low)
+}
+
+func (this *runContainer) remove(low uint16) container {
+	if !this.contains(low) {
+		return this
+	}
+
+	return this.convert().remove(low)
+}
+
+func (this *runContainer) each(f func(low uint16) bool) bool {
+	for _, r := range this.runs {
+		for v := int(r.start); v <= r.last(); v++ {
+			if !f(uint16(v)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func (this *runContainer) numRuns() int {
+	return len(this.runs)
+}
+
+// toRuns returns the run container holding the integers of c
+func toRuns(c container) *runContainer {
+	r := &runContainer{runs: make([]interval, 0, c.numRuns())}
+
+	c.each(func(low uint16) bool {
+		if n := len(r.runs); n > 0 && r.runs[n-1].last()+1 == int(low) {
+			r.runs[n-1].length++
+		} else {
+			r.runs = append(r.runs, interval{start: low})
+		}
+		return true
+	})
+
+	return r
+}
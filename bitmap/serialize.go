@@ -0,0 +1,256 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package bitmap
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/bits"
+	"strconv"
+)
+
+// Constants of the Roaring portable format
+const (
+	// Cookie of the bitmaps without run containers, followed by the number of containers
+	SerialCookieNoRunContainer = 12346
+
+	// Cookie of the bitmaps with run containers, whose upper 16 bits hold the number of
+	// containers minus one
+	SerialCookie = 12347
+
+	// Number of containers from which the offsets of the containers are written even
+	// if there are run containers
+	NoOffsetThreshold = 4
+)
+
+// Serialized sizes of the containers, in bytes
+const (
+	bitmapSize = 8 * bitmapWords
+)
+
+func arraySize(cardinality int) int {
+	return 2 * cardinality
+}
+
+func runSize(runs int) int {
+	return 2 + 4*runs
+}
+
+// WriteTo writes the bitmap to w with the Roaring portable format, and returns the
+// number of bytes written
+func (this *Bitmap) WriteTo(w io.Writer) (int64, error) {
+	size := len(this.keys)
+
+	var runs []byte
+	for i, c := range this.containers {
+		if _, ok := c.(*runContainer); ok {
+			if runs == nil {
+				runs = make([]byte, (size+7)/8)
+			}
+			runs[i/8] |= 1 << uint(i%8)
+		}
+	}
+
+	var header []byte
+	if runs != nil {
+		header = binary.LittleEndian.AppendUint32(header, SerialCookie|uint32(size-1)<<16)
+		header = append(header, runs...)
+	} else {
+		header = binary.LittleEndian.AppendUint32(header, SerialCookieNoRunContainer)
+		header = binary.LittleEndian.AppendUint32(header, uint32(size))
+	}
+
+	for i, key := range this.keys {
+		header = binary.LittleEndian.AppendUint16(header, key)
+		header = binary.LittleEndian.AppendUint16(header, uint16(this.containers[i].cardinality()-1))
+	}
+
+	var data []byte
+	ends := make([]int, size)
+	for i, c := range this.containers {
+		switch c := c.(type) {
+		case *bitmapContainer:
+			for _, w := range c.words {
+				data = binary.LittleEndian.AppendUint64(data, w)
+			}
+		case *runContainer:
+			data = binary.LittleEndian.AppendUint16(data, uint16(len(c.runs)))
+			for _, r := range c.runs {
+				data = binary.LittleEndian.AppendUint16(data, r.start)
+				data = binary.LittleEndian.AppendUint16(data, r.length)
+			}
+		default:
+			this.each(i, func(low uint16) bool {
+				data = binary.LittleEndian.AppendUint16(data, low)
+				return true
+			})
+		}
+		ends[i] = len(data)
+	}
+
+	// The offsets are counted from the start of the bitmap
+	if runs == nil || size >= NoOffsetThreshold {
+		start := len(header) + 4*size
+		for i := range ends {
+			offset := start
+			if i > 0 {
+				offset += ends[i-1]
+			}
+			header = binary.LittleEndian.AppendUint32(header, uint32(offset))
+		}
+	}
+
+	n, err := w.Write(header)
+	if err != nil {
+		return int64(n), err
+	}
+
+	m, err := w.Write(data)
+	return int64(n + m), err
+}
+
+// reader reads from an io.Reader, counting the bytes read and keeping the first error
+type reader struct {
+	r   io.Reader
+	n   int64
+	err error
+}
+
+func (this *reader) read(n int) []byte {
+	b := make([]byte, n)
+	if this.err == nil {
+		var m int
+		m, this.err = io.ReadFull(this.r, b)
+		this.n += int64(m)
+	}
+
+	return b
+}
+
+func (this *reader) uint16() uint16 {
+	return binary.LittleEndian.Uint16(this.read(2))
+}
+
+func (this *reader) uint32() uint32 {
+	return binary.LittleEndian.Uint32(this.read(4))
+}
+
+// ReadFrom replaces the integers of the bitmap with those of the bitmap read from r,
+// written with the Roaring portable format, and returns the number of bytes read.
+// The bitmap is not changed if the data is invalid.
+func (this *Bitmap) ReadFrom(r io.Reader) (int64, error) {
+	rd := &reader{r: r}
+
+	var size int
+	var runs []byte
+	switch cookie := rd.uint32(); {
+	case rd.err != nil:
+	case cookie == SerialCookieNoRunContainer:
+		size = int(rd.uint32())
+		if size > 1<<16 {
+			return rd.n, errors.New("bitmap/ReadFrom: invalid number of containers " + strconv.Itoa(size))
+		}
+	case cookie&0xFFFF == SerialCookie:
+		size = int(cookie>>16) + 1
+		runs = rd.read((size + 7) / 8)
+	default:
+		return rd.n, errors.New("bitmap/ReadFrom: unknown cookie " + strconv.Itoa(int(cookie)))
+	}
+
+	keys := make([]uint16, size)
+	cardinalities := make([]int, size)
+	for i := range keys {
+		keys[i] = rd.uint16()
+		cardinalities[i] = int(rd.uint16()) + 1
+
+		if i > 0 && keys[i] <= keys[i-1] && rd.err == nil {
+			return rd.n, errors.New("bitmap/ReadFrom: keys are not in increasing order")
+		}
+	}
+
+	// The containers are read in order, so their offsets are not needed
+	if runs == nil || size >= NoOffsetThreshold {
+		rd.read(4 * size)
+	}
+
+	containers := make([]container, size)
+	for i := range containers {
+		var err error
+		switch {
+		case runs != nil && runs[i/8]&(1<<uint(i%8)) != 0:
+			containers[i], err = readRuns(rd)
+		case cardinalities[i] <= MaxArraySize:
+			containers[i], err = readArray(rd, cardinalities[i])
+		default:
+			containers[i], err = readBitmap(rd)
+		}
+
+		if rd.err != nil {
+			break
+		}
+		if err == nil && containers[i].cardinality() != cardinalities[i] {
+			err = errors.New("wrong cardinality")
+		}
+		if err != nil {
+			return rd.n, errors.New("bitmap/ReadFrom: container " + strconv.Itoa(i) + ": " + err.Error())
+		}
+	}
+
+	if rd.err != nil {
+		return rd.n, errors.New("bitmap/ReadFrom: " + rd.err.Error())
+	}
+
+	this.keys, this.containers = keys, containers
+
+	return rd.n, nil
+}
+
+func readArray(rd *reader, cardinality int) (container, error) {
+	a := newArrayContainer()
+	a.values = make([]uint16, cardinality)
+	a.n = cardinality
+
+	b := rd.read(arraySize(cardinality))
+	for i := range a.values {
+		a.values[i] = binary.LittleEndian.Uint16(b[2*i:])
+		if i > 0 && a.values[i] <= a.values[i-1] {
+			return nil, errors.New("integers are not in increasing order")
+		}
+	}
+
+	return a, nil
+}
+
+func readBitmap(rd *reader) (container, error) {
+	c := &bitmapContainer{}
+
+	b := rd.read(bitmapSize)
+	for i := range c.words {
+		c.words[i] = binary.LittleEndian.Uint64(b[8*i:])
+		c.n += bits.OnesCount64(c.words[i])
+	}
+
+	return c, nil
+}
+
+func readRuns(rd *reader) (container, error) {
+	c := &runContainer{runs: make([]interval, rd.uint16())}
+
+	for i := range c.runs {
+		c.runs[i] = interval{start: rd.uint16(), length: rd.uint16()}
+
+		if c.runs[i].last() > 0xFFFF {
+			return nil, errors.New("run past the end of the chunk")
+		}
+		if i > 0 && int(c.runs[i].start) <= c.runs[i-1].last() {
+			return nil, errors.New("runs are not in increasing order")
+		}
+	}
+
+	return c, nil
+}
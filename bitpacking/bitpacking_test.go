@@ -0,0 +1,328 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package bitpacking
+
+import (
+	"math/rand"
+	"testing"
+)
+
+const (
+	// Number of random blocks tried for each width
+	rounds = 100
+
+	// Offsets at which the blocks are read and written, so that the routines are
+	// checked not to assume a position of 0
+	inoffset  = 3
+	outoffset = 5
+
+	// Value of the int32s that should not be written
+	sentinel = int32(-0x55555556)
+)
+
+// refPack is the reference implementation: the integers are stored one after the
+// other from the least significant bit of each int32, and straddle int32 boundaries.
+func refPack(in []int32, bit int) []int32 {
+	out := make([]int32, bit)
+	for i, v := range in {
+		for b := 0; b < bit; b++ {
+			if uint32(v)>>uint(b)&1 == 1 {
+				pos := i*bit + b
+				out[pos/32] |= 1 << uint(pos%32)
+			}
+		}
+	}
+	return out
+}
+
+func refUnpack(in []int32, bit int) []int32 {
+	out := make([]int32, 32)
+	for i := range out {
+		for b := 0; b < bit; b++ {
+			pos := i*bit + b
+			if uint32(in[pos/32])>>uint(pos%32)&1 == 1 {
+				out[i] |= 1 << uint(b)
+			}
+		}
+	}
+	return out
+}
+
+func mask(bit int) int32 {
+	return int32(uint32(1<<uint(bit)) - 1)
+}
+
+// blocks returns the blocks of 32 integers of at most bit bits checked for a width:
+// zeros, the largest value, alternating extremes, and random integers.
+func blocks(r *rand.Rand, bit int) [][]int32 {
+	max := mask(bit)
+
+	zeros := make([]int32, 32)
+	ones := make([]int32, 32)
+	alternating := make([]int32, 32)
+	for i := range ones {
+		ones[i] = max
+		if i%2 == 0 {
+			alternating[i] = max
+		}
+	}
+
+	result := [][]int32{zeros, ones, alternating}
+	for k := 0; k < rounds; k++ {
+		block := make([]int32, 32)
+		for i := range block {
+			block[i] = int32(r.Uint32()) & max
+		}
+		result = append(result, block)
+	}
+
+	return result
+}
+
+// pad returns a copy of block starting at offset, surrounded by sentinels
+func pad(block []int32, offset, length int) []int32 {
+	out := make([]int32, offset+length+offset)
+	for i := range out {
+		out[i] = sentinel
+	}
+	copy(out[offset:], block)
+	return out
+}
+
+// checkPacked compares the bit int32s written at outoffset with expected, and
+// checks that nothing was written around them
+func checkPacked(t *testing.T, name string, bit int, out, expected []int32) {
+	for i, v := range out {
+		if i >= outoffset && i < outoffset+bit {
+			if v != expected[i-outoffset] {
+				t.Fatalf("%s(%d): int32 %d is %#x, expected %#x", name, bit, i-outoffset, uint32(v), uint32(expected[i-outoffset]))
+			}
+		} else if v != sentinel {
+			t.Fatalf("%s(%d): wrote int32 %d outside of the packed block", name, bit, i-outoffset)
+		}
+	}
+}
+
+// checkUnpacked compares the 32 integers written at outoffset with expected, and
+// checks that nothing was written around them
+func checkUnpacked(t *testing.T, name string, bit int, out, expected []int32) {
+	for i, v := range out {
+		if i >= outoffset && i < outoffset+32 {
+			if v != expected[i-outoffset] {
+				t.Fatalf("%s(%d): integer %d is %d, expected %d", name, bit, i-outoffset, v, expected[i-outoffset])
+			}
+		} else if v != sentinel {
+			t.Fatalf("%s(%d): wrote integer %d outside of the unpacked block", name, bit, i-outoffset)
+		}
+	}
+}
+
+func TestFastPack(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for bit := 0; bit <= 32; bit++ {
+		for _, block := range blocks(r, bit) {
+			expected := refPack(block, bit)
+
+			out := pad(nil, outoffset, bit)
+			if err := FastPack(pad(block, inoffset, 32), inoffset, out, outoffset, bit); err != nil {
+				t.Fatal(err)
+			}
+			checkPacked(t, "FastPack", bit, out, expected)
+
+			out = pad(nil, outoffset, bit)
+			if err := FastPackWithoutMask(pad(block, inoffset, 32), inoffset, out, outoffset, bit); err != nil {
+				t.Fatal(err)
+			}
+			checkPacked(t, "FastPackWithoutMask", bit, out, expected)
+		}
+	}
+}
+
+// TestFastPackMask checks that FastPack only keeps the lowest bits of integers wider
+// than the width
+func TestFastPackMask(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+
+	for bit := 0; bit <= 32; bit++ {
+		for k := 0; k < rounds; k++ {
+			block := make([]int32, 32)
+			masked := make([]int32, 32)
+			for i := range block {
+				block[i] = int32(r.Uint32())
+				masked[i] = block[i] & mask(bit)
+			}
+
+			out := pad(nil, outoffset, bit)
+			if err := FastPack(pad(block, inoffset, 32), inoffset, out, outoffset, bit); err != nil {
+				t.Fatal(err)
+			}
+			checkPacked(t, "FastPack", bit, out, refPack(masked, bit))
+		}
+	}
+}
+
+func TestFastUnpack(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+
+	for bit := 0; bit <= 32; bit++ {
+		for _, block := range blocks(r, bit) {
+			out := pad(nil, outoffset, 32)
+			if err := FastUnpack(pad(refPack(block, bit), inoffset, bit), inoffset, out, outoffset, bit); err != nil {
+				t.Fatal(err)
+			}
+			checkUnpacked(t, "FastUnpack", bit, out, block)
+		}
+
+		// Any bit pattern is a valid packed block
+		for k := 0; k < rounds; k++ {
+			packed := make([]int32, bit)
+			for i := range packed {
+				packed[i] = int32(r.Uint32())
+			}
+
+			out := pad(nil, outoffset, 32)
+			if err := FastUnpack(pad(packed, inoffset, bit), inoffset, out, outoffset, bit); err != nil {
+				t.Fatal(err)
+			}
+			checkUnpacked(t, "FastUnpack", bit, out, refUnpack(packed, bit))
+		}
+	}
+}
+
+// deltas returns the integers whose successive differences, starting from initoffset,
+// are the integers of block. Like the routines, it wraps around on overflow.
+func deltas(initoffset int32, block []int32) []int32 {
+	out := make([]int32, len(block))
+	prev := initoffset
+	for i, d := range block {
+		out[i] = prev + d
+		prev = out[i]
+	}
+	return out
+}
+
+func TestDeltaPack(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+
+	for bit := 0; bit <= 32; bit++ {
+		for _, block := range blocks(r, bit) {
+			initoffset := int32(r.Uint32())
+			in := deltas(initoffset, block)
+
+			// Integers that need all 32 bits are copied as is, not their differences
+			expected := refPack(block, bit)
+			if bit == 32 {
+				expected = in
+			}
+
+			out := pad(nil, outoffset, bit)
+			if err := DeltaPack(initoffset, pad(in, inoffset, 32), inoffset, out, outoffset, bit); err != nil {
+				t.Fatal(err)
+			}
+			checkPacked(t, "DeltaPack", bit, out, expected)
+
+			unpacked := pad(nil, outoffset, 32)
+			if err := DeltaUnpack(initoffset, pad(out[outoffset:outoffset+bit], inoffset, bit), inoffset, unpacked, outoffset, bit); err != nil {
+				t.Fatal(err)
+			}
+			checkUnpacked(t, "DeltaUnpack", bit, unpacked, in)
+		}
+	}
+}
+
+func TestDeltaUnpack(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+
+	for bit := 0; bit < 32; bit++ {
+		for k := 0; k < rounds; k++ {
+			packed := make([]int32, bit)
+			for i := range packed {
+				packed[i] = int32(r.Uint32())
+			}
+			initoffset := int32(r.Uint32())
+
+			out := pad(nil, outoffset, 32)
+			if err := DeltaUnpack(initoffset, pad(packed, inoffset, bit), inoffset, out, outoffset, bit); err != nil {
+				t.Fatal(err)
+			}
+			checkUnpacked(t, "DeltaUnpack", bit, out, deltas(initoffset, refUnpack(packed, bit)))
+		}
+	}
+}
+
+// zigzagDeltas returns the zigzag encoded differences between successive integers of
+// block, starting from initoffset
+func zigzagDeltas(initoffset int32, block []int32) []int32 {
+	out := make([]int32, len(block))
+	prev := initoffset
+	for i, v := range block {
+		n := v - prev
+		out[i] = (n << 1) ^ (n >> 31)
+		prev = v
+	}
+	return out
+}
+
+func TestZigZagDeltaPack(t *testing.T) {
+	r := rand.New(rand.NewSource(6))
+
+	for bit := 0; bit <= 32; bit++ {
+		for _, block := range blocks(r, bit) {
+			initoffset := int32(r.Uint32())
+
+			// Differences whose zigzag encodings are the integers of block
+			in := make([]int32, 32)
+			prev := initoffset
+			for i, v := range block {
+				in[i] = prev + (int32(uint32(v)>>1) ^ -(v & 1))
+				prev = in[i]
+			}
+
+			out := pad(nil, outoffset, bit)
+			if err := ZigZagDeltaPack(initoffset, pad(in, inoffset, 32), inoffset, out, outoffset, bit); err != nil {
+				t.Fatal(err)
+			}
+			checkPacked(t, "ZigZagDeltaPack", bit, out, refPack(zigzagDeltas(initoffset, in), bit))
+
+			unpacked := pad(nil, outoffset, 32)
+			if err := ZigZagDeltaUnpack(initoffset, pad(out[outoffset:outoffset+bit], inoffset, bit), inoffset, unpacked, outoffset, bit); err != nil {
+				t.Fatal(err)
+			}
+			checkUnpacked(t, "ZigZagDeltaUnpack", bit, unpacked, in)
+		}
+	}
+}
+
+func TestUnsupportedWidth(t *testing.T) {
+	in := make([]int32, 64)
+	out := make([]int32, 64)
+
+	for _, bit := range []int{-1, 33, 64} {
+		if FastPack(in, 0, out, 0, bit) == nil {
+			t.Errorf("FastPack(%d): expected an error", bit)
+		}
+		if FastPackWithoutMask(in, 0, out, 0, bit) == nil {
+			t.Errorf("FastPackWithoutMask(%d): expected an error", bit)
+		}
+		if FastUnpack(in, 0, out, 0, bit) == nil {
+			t.Errorf("FastUnpack(%d): expected an error", bit)
+		}
+		if DeltaPack(0, in, 0, out, 0, bit) == nil {
+			t.Errorf("DeltaPack(%d): expected an error", bit)
+		}
+		if DeltaUnpack(0, in, 0, out, 0, bit) == nil {
+			t.Errorf("DeltaUnpack(%d): expected an error", bit)
+		}
+		if ZigZagDeltaPack(0, in, 0, out, 0, bit) == nil {
+			t.Errorf("ZigZagDeltaPack(%d): expected an error", bit)
+		}
+		if ZigZagDeltaUnpack(0, in, 0, out, 0, bit) == nil {
+			t.Errorf("ZigZagDeltaUnpack(%d): expected an error", bit)
+		}
+	}
+}
@@ -0,0 +1,400 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Command gen generates the bit packing routines of the bitpacking package. It is
+// run by go generate from the bitpacking directory, and writes its files there.
+//
+// The routines come in families, such as fastpack or deltaunpack. Each family has one
+// unrolled function per bit width from 0 to 32, all of them packing or unpacking 32
+// integers, and an exported function dispatching on the bit width. The integers are
+// stored one after the other from the least significant bit of each int32.
+//
+// A family only describes how an integer is read before being packed, or written
+// after being unpacked, so that new families, e.g. fusing another transform with the
+// bit packing, can be added by appending to the families of a file.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"strings"
+	"text/template"
+)
+
+type family struct {
+	// Name of the unexported functions, followed by the bit width
+	name string
+
+	// Name of the exported function dispatching on the bit width
+	dispatcher string
+
+	// Additional leading parameters, such as the initial offset of the delta families
+	params string
+	args   string
+
+	// Whether the family packs integers; otherwise it unpacks them
+	pack bool
+
+	// For packing families, the expression of the i-th integer to pack. For unpacking
+	// families, the expression of the i-th integer to write given the expression of
+	// its unpacked bits.
+	value func(i int, bit int, unpacked string) string
+
+	// Bodies of the functions for widths 0 and 32, where no bits are shifted
+	zero string
+	full string
+}
+
+type file struct {
+	name     string
+	comment  string
+	families []family
+
+	// Unexported functions used by the routines, written at the end of the file
+	helpers string
+}
+
+var files = []file{
+	{
+		name: "bitpacking.go",
+		comment: `// bit packing routines
+//
+// Original author: Daniel Lemire
+// Copied from the JavaFastPFOR project`,
+		families: []family{
+			{
+				name:       "fastunpack",
+				dispatcher: "FastUnpack",
+				value: func(i, bit int, unpacked string) string {
+					return unpacked
+				},
+				zero: `for i := outpos; i < outpos+32; i++ {
+					out[i] = 0
+				}`,
+				full: `copy(out[outpos:outpos+32], in[inpos:inpos+32])`,
+			},
+			{
+				name:       "fastpack",
+				dispatcher: "FastPack",
+				pack:       true,
+				value: func(i, bit int, _ string) string {
+					return fmt.Sprintf("(in[%d+inpos] & %d)", i, mask(bit))
+				},
+				zero: `// nothing`,
+				full: `copy(out[outpos:outpos+32], in[inpos:inpos+32])`,
+			},
+			{
+				name:       "fastpackwithoutmask",
+				dispatcher: "FastPackWithoutMask",
+				pack:       true,
+				value: func(i, bit int, _ string) string {
+					return fmt.Sprintf("in[%d+inpos]", i)
+				},
+				zero: `// nothing`,
+				full: `copy(out[outpos:outpos+32], in[inpos:inpos+32])`,
+			},
+		},
+	},
+	{
+		name: "delta_bitpacking.go",
+		comment: `// "Delta" bit packing routines: they include
+// both the bit packing and the differential coding.
+//
+// Original author: Daniel Lemire
+// Copied from the JavaFastPFOR project`,
+		families: []family{
+			{
+				name:       "deltapack",
+				dispatcher: "DeltaPack",
+				params:     "initoffset int32, ",
+				args:       "initoffset, ",
+				pack:       true,
+				value: func(i, bit int, _ string) string {
+					if i == 0 {
+						return "(in[0+inpos] - initoffset)"
+					}
+					return fmt.Sprintf("(in[%d+inpos] - in[%d+inpos])", i, i-1)
+				},
+				zero: `// nothing`,
+				// Differences needing all 32 bits are no smaller than the integers
+				full: `copy(out[outpos:outpos+32], in[inpos:inpos+32])`,
+			},
+			{
+				name:       "deltaunpack",
+				dispatcher: "DeltaUnpack",
+				params:     "initoffset int32, ",
+				args:       "initoffset, ",
+				value: func(i, bit int, unpacked string) string {
+					if i == 0 {
+						return fmt.Sprintf("(%s) + initoffset", unpacked)
+					}
+					return fmt.Sprintf("(%s) + out[%d+outpos]", unpacked, i-1)
+				},
+				zero: `for i := outpos; i < outpos+32; i++ {
+					out[i] = initoffset
+				}`,
+				full: `copy(out[outpos:outpos+32], in[inpos:inpos+32])`,
+			},
+		},
+	},
+	{
+		name: "zigzag_bitpacking.go",
+		comment: `// "ZigZag delta" bit packing routines: they include the bit packing,
+// the differential coding and the zigzag encoding of the differences,
+// so that decreasing integers are also packed in few bits.`,
+		families: []family{
+			{
+				name:       "zigzagdeltapack",
+				dispatcher: "ZigZagDeltaPack",
+				params:     "initoffset int32, ",
+				args:       "initoffset, ",
+				pack:       true,
+				value: func(i, bit int, _ string) string {
+					if i == 0 {
+						return "zigzag(in[0+inpos] - initoffset)"
+					}
+					return fmt.Sprintf("zigzag(in[%d+inpos] - in[%d+inpos])", i, i-1)
+				},
+				zero: `// nothing`,
+				full: `out[outpos] = zigzag(in[inpos] - initoffset)
+				for i := 1; i < 32; i++ {
+					out[outpos+i] = zigzag(in[inpos+i] - in[inpos+i-1])
+				}`,
+			},
+			{
+				name:       "zigzagdeltaunpack",
+				dispatcher: "ZigZagDeltaUnpack",
+				params:     "initoffset int32, ",
+				args:       "initoffset, ",
+				value: func(i, bit int, unpacked string) string {
+					if i == 0 {
+						return fmt.Sprintf("unzigzag(%s) + initoffset", unpacked)
+					}
+					return fmt.Sprintf("unzigzag(%s) + out[%d+outpos]", unpacked, i-1)
+				},
+				zero: `for i := outpos; i < outpos+32; i++ {
+					out[i] = initoffset
+				}`,
+				full: `out[outpos] = unzigzag(in[inpos]) + initoffset
+				for i := 1; i < 32; i++ {
+					out[outpos+i] = unzigzag(in[inpos+i]) + out[outpos+i-1]
+				}`,
+			},
+		},
+		helpers: `// https://developers.google.com/protocol-buffers/docs/encoding#types
+func zigzag(n int32) int32 {
+	return (n << 1) ^ (n >> 31)
+}
+
+func unzigzag(v int32) int32 {
+	return int32(uint32(v)>>1) ^ ((v << 31) >> 31)
+}
+`,
+	},
+}
+
+var tmpl = template.Must(template.New("file").Parse(`/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Code generated by gen/main.go. DO NOT EDIT.
+
+package bitpacking
+
+import (
+	"errors"
+)
+
+{{.Comment}}
+{{- range $i, $f := .Families}}
+{{if $i}}
+{{end -}}
+func {{.Dispatcher}}({{.Params}}in []int32, inpos int, out []int32, outpos int, bit int) error {
+	switch bit {
+	{{- range $bit := $.Widths}}
+	case {{$bit}}:
+		{{$f.Name}}{{$bit}}({{$f.Args}}in, inpos, out, outpos)
+	{{end}}
+	default:
+		return errors.New("bitpacking/{{.Name}}: Unsupported bit width")
+	}
+
+	return nil
+}
+{{end}}
+{{- range .Families}}
+{{- $f := .}}
+{{- range .Kernels}}
+func {{$f.Name}}{{.Bit}}({{$f.Params}}in []int32, inpos int, out []int32, outpos int) {
+	{{.Body}}
+}
+{{end}}
+{{- end}}
+{{- with .Helpers}}
+{{.}}
+{{- end}}`))
+
+type familyData struct {
+	Name       string
+	Dispatcher string
+	Params     string
+	Args       string
+	Kernels    []kernelData
+}
+
+type kernelData struct {
+	Bit  int
+	Body string
+}
+
+func main() {
+	for _, f := range files {
+		if err := generate(f); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func generate(f file) error {
+	data := struct {
+		Comment  string
+		Widths   []int
+		Families []familyData
+		Helpers  string
+	}{
+		Comment: f.comment,
+		Helpers: f.helpers,
+	}
+
+	for bit := 0; bit <= 32; bit++ {
+		data.Widths = append(data.Widths, bit)
+	}
+
+	for _, fam := range f.families {
+		fd := familyData{
+			Name:       fam.name,
+			Dispatcher: fam.dispatcher,
+			Params:     fam.params,
+			Args:       fam.args,
+		}
+
+		for bit := 0; bit <= 32; bit++ {
+			var body string
+			switch {
+			case bit == 0:
+				body = fam.zero
+			case bit == 32:
+				body = fam.full
+			case fam.pack:
+				body = packBody(fam, bit)
+			default:
+				body = unpackBody(fam, bit)
+			}
+			fd.Kernels = append(fd.Kernels, kernelData{bit, body})
+		}
+
+		data.Families = append(data.Families, fd)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("%s: %v", f.name, err)
+	}
+
+	return ioutil.WriteFile(f.name, src, 0644)
+}
+
+// packBody writes the bit int32s packing 32 integers of bit bits each. The integers
+// straddling two int32s have their low bits at the top of the first one, and their
+// high bits at the bottom of the second one.
+func packBody(fam family, bit int) string {
+	var b strings.Builder
+
+	for w := 0; w < bit; w++ {
+		var terms []string
+
+		for i := 0; i < 32; i++ {
+			start, end := i*bit, (i+1)*bit
+			if end <= w*32 || start >= (w+1)*32 {
+				continue
+			}
+
+			v := fam.value(i, bit, "")
+			if start < w*32 {
+				terms = append(terms, fmt.Sprintf("int32(uint32%s >> %d)", paren(v), w*32-start))
+			} else if shift := start - w*32; shift == 0 {
+				terms = append(terms, v)
+			} else {
+				terms = append(terms, fmt.Sprintf("(%s << %d)", v, shift))
+			}
+		}
+
+		fmt.Fprintf(&b, "out[%d+outpos] = %s\n\n", w, strings.Join(terms, " |\n"))
+	}
+
+	return b.String()
+}
+
+// unpackBody writes the 32 integers of bit bits each packed in bit int32s
+func unpackBody(fam family, bit int) string {
+	var b strings.Builder
+
+	for i := 0; i < 32; i++ {
+		start, end := i*bit, (i+1)*bit
+		w, shift := start/32, start%32
+
+		var unpacked string
+		switch {
+		case end < (w+1)*32:
+			unpacked = fmt.Sprintf("int32(uint32(in[%d+inpos]) >> %d) & %d", w, shift, mask(bit))
+		case end == (w+1)*32:
+			unpacked = fmt.Sprintf("int32(uint32(in[%d+inpos]) >> %d)", w, shift)
+		default:
+			high := end - (w+1)*32
+			unpacked = fmt.Sprintf("int32(uint32(in[%d+inpos]) >> %d) |\n((in[%d+inpos] & %d) << %d)", w, shift, w+1, mask(high), bit-high)
+		}
+
+		fmt.Fprintf(&b, "out[%d+outpos] = %s\n\n", i, fam.value(i, bit, unpacked))
+	}
+
+	return b.String()
+}
+
+// paren returns v enclosed in parentheses, unless it already is
+func paren(v string) string {
+	depth := 0
+	for i, c := range v {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+
+		if depth == 0 {
+			if i == len(v)-1 {
+				return v
+			}
+			break
+		}
+	}
+
+	return "(" + v + ")"
+}
+
+func mask(bit int) uint32 {
+	return uint32(1)<<uint(bit) - 1
+}
@@ -0,0 +1,13 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package bitpacking packs blocks of 32 integers of the same bit width into as many
+// int32s as the width, and unpacks them.
+// The routines are generated by gen/main.go: to change them, edit the generator and
+// run go generate in this directory.
+package bitpacking
+
+//go:generate go run gen/main.go
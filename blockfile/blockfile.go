@@ -0,0 +1,186 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package blockfile writes and reads files of integers compressed in independent
+// blocks, with an index of the blocks in a footer, so that any block can be decoded
+// without reading the others.
+// Files are read by mapping them in memory where possible, the blocks being decoded
+// straight from the mapped memory, seen as int32s without being copied.
+// A file is made of little-endian int32s:
+//
+//	header:  Magic, Version, identifier of the codec in package auto, block size
+//	blocks:  the blocks, each compressed with the codec
+//	footer:  for each block, its position, as two int32s, and its number of integers
+//	trailer: the position of the footer, as two int32s, the number of blocks and Magic
+//
+// Positions are counted in int32s from the start of the file, the most significant
+// int32 first.
+package blockfile
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/auto"
+	"github.com/dataence/encoding/cursor"
+)
+
+const (
+	// "BLKF" in little-endian order
+	Magic   = 0x464b4c42
+	Version = 1
+
+	// Identifiers of the codecs in package auto
+	BP32     = 2
+	FastPFOR = 3
+
+	// Number of integers of a block, that of a FastPFOR page
+	DefaultBlockSize = 65536
+
+	headerSize  = 4
+	entrySize   = 3
+	trailerSize = 4
+)
+
+// codec returns a new instance of the codec registered in package auto with id, which
+// must be BP32 or FastPFOR
+func codec(id int32) (encoding.Integer, error) {
+	if id != BP32 && id != FastPFOR {
+		return nil, errors.New("unsupported codec " + strconv.Itoa(int(id)))
+	}
+
+	for _, c := range auto.Codecs() {
+		if c.ID == id {
+			return c.New(), nil
+		}
+	}
+
+	return nil, errors.New("unknown codec " + strconv.Itoa(int(id)))
+}
+
+// Writer writes integers to a file, compressing them one block at a time
+type Writer struct {
+	w         io.Writer
+	codec     encoding.Integer
+	blockSize int
+
+	// Number of int32s written, and the footer
+	pos    int64
+	footer []int32
+
+	// Integers not written yet, and working area
+	pending []int32
+	buf     []int32
+	bytes   []byte
+}
+
+// NewWriter returns a writer compressing blocks of blockSize integers with the codec
+// registered in package auto with id, BP32 or FastPFOR, and writes the header to w
+func NewWriter(w io.Writer, id int32, blockSize int) (*Writer, error) {
+	if blockSize <= 0 {
+		return nil, errors.New("blockfile/NewWriter: block size must be positive")
+	}
+
+	c, err := codec(id)
+	if err != nil {
+		return nil, errors.New("blockfile/NewWriter: " + err.Error())
+	}
+
+	this := &Writer{
+		w:         w,
+		codec:     c,
+		blockSize: blockSize,
+		pending:   make([]int32, 0, blockSize),
+		buf:       make([]int32, 2*blockSize+1024),
+	}
+
+	if err := this.write([]int32{Magic, Version, id, int32(blockSize)}); err != nil {
+		return nil, errors.New("blockfile/NewWriter: " + err.Error())
+	}
+
+	return this, nil
+}
+
+// write writes words to the underlying writer
+func (this *Writer) write(words []int32) error {
+	if cap(this.bytes) < 4*len(words) {
+		this.bytes = make([]byte, 4*len(words))
+	}
+	b := this.bytes[:4*len(words)]
+
+	for i, v := range words {
+		binary.LittleEndian.PutUint32(b[4*i:], uint32(v))
+	}
+
+	if _, err := this.w.Write(b); err != nil {
+		return err
+	}
+	this.pos += int64(len(words))
+
+	return nil
+}
+
+// Write adds values to the file, writing every block as soon as it is full
+func (this *Writer) Write(values ...int32) error {
+	for len(values) > 0 {
+		n := this.blockSize - len(this.pending)
+		if n > len(values) {
+			n = len(values)
+		}
+		this.pending = append(this.pending, values[:n]...)
+		values = values[n:]
+
+		if len(this.pending) == this.blockSize {
+			if err := this.flush(); err != nil {
+				return errors.New("blockfile/Write: " + err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+// flush writes the integers not written yet as a block
+func (this *Writer) flush() error {
+	n := len(this.pending)
+	if n == 0 {
+		return nil
+	}
+
+	inpos, outpos := cursor.New(), cursor.New()
+	if err := this.codec.Compress(this.pending, inpos, n, this.buf, outpos); err != nil {
+		return err
+	}
+	if inpos.Get() != n {
+		return errors.New("codec compressed only part of the integers")
+	}
+
+	this.footer = append(this.footer, int32(this.pos>>32), int32(this.pos), int32(n))
+	if err := this.write(this.buf[:outpos.Get()]); err != nil {
+		return err
+	}
+	this.pending = this.pending[:0]
+
+	return nil
+}
+
+// Close writes the last block and the footer. It does not close the underlying writer.
+func (this *Writer) Close() error {
+	if err := this.flush(); err != nil {
+		return errors.New("blockfile/Close: " + err.Error())
+	}
+
+	pos := this.pos
+	blocks := len(this.footer) / entrySize
+	if err := this.write(append(this.footer, int32(pos>>32), int32(pos), int32(blocks), Magic)); err != nil {
+		return errors.New("blockfile/Close: " + err.Error())
+	}
+
+	return nil
+}
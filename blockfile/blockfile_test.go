@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package blockfile
+
+import (
+	"bytes"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dataence/encoding/generators"
+)
+
+var (
+	data []int32
+)
+
+func init() {
+	data = generators.GenerateClustered(300000, 1<<24)
+}
+
+func write(t *testing.T, id int32, blockSize int, values []int32) []byte {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, id, blockSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Written in uneven batches, so that blocks span several calls
+	for i := 0; i < len(values); i += 1000 {
+		end := i + 1000
+		if end > len(values) {
+			end = len(values)
+		}
+		if err := w.Write(values[i:end]...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestOpen(t *testing.T) {
+	for _, id := range []int32{BP32, FastPFOR} {
+		path := filepath.Join(t.TempDir(), "blocks")
+		if err := os.WriteFile(path, write(t, id, DefaultBlockSize, data), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		r, err := Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r.Len() != len(data) {
+			t.Fatalf("blockfile/TestOpen: codec %d: %d integers, expected %d", id, r.Len(), len(data))
+		}
+		if blocks := (len(data) + DefaultBlockSize - 1) / DefaultBlockSize; r.NumBlocks() != blocks {
+			t.Fatalf("blockfile/TestOpen: codec %d: %d blocks, expected %d", id, r.NumBlocks(), blocks)
+		}
+
+		// Blocks are decoded in random order
+		var out []int32
+		for _, b := range rand.New(rand.NewSource(1)).Perm(r.NumBlocks()) {
+			if out, err = r.Block(b, out); err != nil {
+				t.Fatal(err)
+			}
+
+			start := b * DefaultBlockSize
+			for i, v := range out {
+				if v != data[start+i] {
+					t.Fatalf("blockfile/TestOpen: codec %d: integer %d of block %d is %d, expected %d", id, i, b, v, data[start+i])
+				}
+			}
+		}
+
+		if err := r.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestFind(t *testing.T) {
+	r, err := NewReader(write(t, BP32, 1000, data[:10500]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, i := range []int{0, 999, 1000, 5432, 10499} {
+		b, j := r.Find(i)
+		out, err := r.Block(b, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out[j] != data[i] {
+			t.Fatalf("blockfile/TestFind: integer %d is %d, expected %d", i, out[j], data[i])
+		}
+	}
+
+	if _, err := r.Block(r.NumBlocks(), nil); err == nil {
+		t.Fatalf("blockfile/TestFind: no error for a block out of range")
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	r, err := NewReader(write(t, FastPFOR, DefaultBlockSize, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Len() != 0 || r.NumBlocks() != 0 {
+		t.Fatalf("blockfile/TestEmpty: %d integers in %d blocks, expected none", r.Len(), r.NumBlocks())
+	}
+}
+
+func TestCorrupted(t *testing.T) {
+	file := write(t, BP32, 4096, data[:50000])
+
+	if _, err := NewReader(file[:len(file)-4]); err == nil {
+		t.Fatalf("blockfile/TestCorrupted: no error for a truncated file")
+	}
+	if _, err := NewWriter(&bytes.Buffer{}, 0, DefaultBlockSize); err == nil {
+		t.Fatalf("blockfile/TestCorrupted: no error for an unknown codec")
+	}
+
+	// Only BP32 and FastPFOR are accepted, even if other codecs are registered in auto
+	if _, err := NewWriter(&bytes.Buffer{}, 6, DefaultBlockSize); err == nil {
+		t.Fatalf("blockfile/TestCorrupted: no error for an unsupported codec")
+	}
+	unsupported := append([]byte{}, file...)
+	unsupported[8] = 6
+	if _, err := NewReader(unsupported); err == nil {
+		t.Fatalf("blockfile/TestCorrupted: no error for an unsupported codec")
+	}
+
+	// Flipping bytes must not make decoding panic
+	r := rand.New(rand.NewSource(1))
+	for k := 0; k < 200; k++ {
+		corrupted := append([]byte{}, file...)
+		for j := 0; j < 4; j++ {
+			corrupted[r.Intn(len(corrupted))] ^= byte(1 + r.Intn(255))
+		}
+
+		reader, err := NewReader(corrupted)
+		if err != nil {
+			continue
+		}
+		for b := 0; b < reader.NumBlocks(); b++ {
+			reader.Block(b, nil)
+		}
+	}
+}
+
+func BenchmarkBlock(b *testing.B) {
+	b.StopTimer()
+	var buf bytes.Buffer
+	w, _ := NewWriter(&buf, FastPFOR, DefaultBlockSize)
+	w.Write(data...)
+	w.Close()
+	r, _ := NewReader(buf.Bytes())
+	out := make([]int32, DefaultBlockSize)
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		r.Block(j%r.NumBlocks(), out)
+	}
+}
@@ -0,0 +1,215 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package blockfile
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"sort"
+	"strconv"
+	"unsafe"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/cursor"
+)
+
+// littleEndian reports whether the host stores int32s in little-endian order, in which
+// case the bytes of a file can be seen as its int32s
+var littleEndian = func() bool {
+	x := uint16(1)
+	return *(*byte)(unsafe.Pointer(&x)) == 1
+}()
+
+// int32s returns the int32s held by data, without copying them if possible
+func int32s(data []byte) []int32 {
+	if len(data) < 4 {
+		return nil
+	}
+
+	if littleEndian && uintptr(unsafe.Pointer(&data[0]))%4 == 0 {
+		return unsafe.Slice((*int32)(unsafe.Pointer(&data[0])), len(data)/4)
+	}
+
+	words := make([]int32, len(data)/4)
+	for i := range words {
+		words[i] = int32(binary.LittleEndian.Uint32(data[4*i:]))
+	}
+
+	return words
+}
+
+// Reader reads the blocks of a file: this is not thread-safe, as the codec has a working
+// area (need one per thread)
+type Reader struct {
+	words     []int32
+	codec     encoding.Integer
+	blockSize int
+
+	// Position of each block and of the footer, and index of the first integer of each
+	// block and of the end
+	positions []int
+	starts    []int
+
+	// Releases the memory mapping, if any
+	unmap func() error
+}
+
+// Open maps the file at path in memory, or reads it where mapping is not supported,
+// and returns a reader of its blocks, which must be closed
+func Open(path string) (*Reader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if fi.Size() < 4*(headerSize+trailerSize) || fi.Size() != int64(int(fi.Size())) {
+		return nil, errors.New("blockfile/Open: invalid size " + strconv.FormatInt(fi.Size(), 10))
+	}
+
+	data, unmap, err := mmap(f, int(fi.Size()))
+	if err != nil {
+		return nil, errors.New("blockfile/Open: " + err.Error())
+	}
+
+	this, err := NewReader(data)
+	if err != nil {
+		unmap()
+		return nil, err
+	}
+	this.unmap = unmap
+
+	return this, nil
+}
+
+// NewReader returns a reader of the blocks of the file held by data, which must not
+// change while the reader is used
+func NewReader(data []byte) (*Reader, error) {
+	if len(data)%4 != 0 || len(data) < 4*(headerSize+trailerSize) {
+		return nil, errors.New("blockfile/NewReader: invalid size " + strconv.Itoa(len(data)))
+	}
+
+	words := int32s(data)
+	n := len(words)
+
+	if words[0] != Magic || words[n-1] != Magic {
+		return nil, errors.New("blockfile/NewReader: not a block file")
+	}
+	if words[1] != Version {
+		return nil, errors.New("blockfile/NewReader: unsupported version " + strconv.Itoa(int(words[1])))
+	}
+
+	c, err := codec(words[2])
+	if err != nil {
+		return nil, errors.New("blockfile/NewReader: " + err.Error())
+	}
+
+	blockSize := int(words[3])
+	footer := int64(words[n-4])<<32 | int64(uint32(words[n-3]))
+	blocks := int(words[n-2])
+	if blockSize <= 0 || blocks < 0 || footer < headerSize || footer != int64(n-trailerSize-entrySize*blocks) {
+		return nil, errors.New("blockfile/NewReader: invalid trailer. Data may be corrupted.")
+	}
+
+	this := &Reader{
+		words:     words,
+		codec:     c,
+		blockSize: blockSize,
+		positions: make([]int, blocks+1),
+		starts:    make([]int, blocks+1),
+	}
+
+	// The positions must increase, so that every block stays before the footer
+	entries := words[footer : n-trailerSize]
+	for b := 0; b < blocks; b++ {
+		e := entries[entrySize*b : entrySize*(b+1)]
+		pos := int64(e[0])<<32 | int64(uint32(e[1]))
+		count := int(e[2])
+
+		if pos < headerSize || (b > 0 && pos <= int64(this.positions[b-1])) || pos >= footer || count <= 0 || count > blockSize {
+			return nil, errors.New("blockfile/NewReader: invalid footer entry " + strconv.Itoa(b) + ". Data may be corrupted.")
+		}
+
+		this.positions[b] = int(pos)
+		this.starts[b+1] = this.starts[b] + count
+	}
+	this.positions[blocks] = int(footer)
+
+	return this, nil
+}
+
+// Close releases the memory mapping of the file, after which the reader must not be
+// used. The integers decoded by Block stay valid, as they are in the slices of the caller.
+func (this *Reader) Close() error {
+	this.words = nil
+	if this.unmap == nil {
+		return nil
+	}
+
+	unmap := this.unmap
+	this.unmap = nil
+	if err := unmap(); err != nil {
+		return errors.New("blockfile/Close: " + err.Error())
+	}
+
+	return nil
+}
+
+// Len returns the number of integers
+func (this *Reader) Len() int {
+	return this.starts[len(this.starts)-1]
+}
+
+// NumBlocks returns the number of blocks
+func (this *Reader) NumBlocks() int {
+	return len(this.positions) - 1
+}
+
+// BlockSize returns the largest number of integers of a block
+func (this *Reader) BlockSize() int {
+	return this.blockSize
+}
+
+// Find returns the block holding the i-th integer, and the index of the integer in it
+func (this *Reader) Find(i int) (int, int) {
+	b := sort.Search(this.NumBlocks(), func(b int) bool {
+		return this.starts[b+1] > i
+	})
+
+	return b, i - this.starts[b]
+}
+
+// Block decodes block b into out, which is grown if it is too small, and returns the
+// integers of the block. The block is decoded straight from the file's memory.
+func (this *Reader) Block(b int, out []int32) ([]int32, error) {
+	if b < 0 || b >= this.NumBlocks() {
+		return nil, errors.New("blockfile/Block: block " + strconv.Itoa(b) + " out of range")
+	}
+
+	n := this.starts[b+1] - this.starts[b]
+	if cap(out) < n {
+		out = make([]int32, n)
+	}
+	out = out[:n]
+
+	from, to := this.positions[b], this.positions[b+1]
+	inpos, outpos := cursor.New(), cursor.New()
+	inpos.Set(from)
+	if err := this.codec.Uncompress(this.words[:to], inpos, to-from, out, outpos); err != nil {
+		return nil, errors.New("blockfile/Block: block " + strconv.Itoa(b) + ": " + err.Error())
+	}
+	if inpos.Get() != to || outpos.Get() != n {
+		return nil, errors.New("blockfile/Block: block " + strconv.Itoa(b) + ": wrong number of integers. Data may be corrupted.")
+	}
+
+	return out, nil
+}
@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package bp32
+
+import (
+	"errors"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/bitpacking"
+)
+
+// BlockReader goes through the blocks of integers compressed by BP32, reading their
+// headers only, so that the blocks that are not needed are skipped without being
+// decoded
+type BlockReader struct {
+	in []int32
+
+	// Number of blocks, index of the current block, and position of its header and
+	// of the header of the next block
+	blocks int
+	block  int
+	pos    int
+	next   int
+
+	err error
+}
+
+// NewBlockReader returns a reader of the blocks compressed in in, starting at inpos,
+// positioned before the first block
+func NewBlockReader(in []int32, inpos int) (*BlockReader, error) {
+	if inpos < 0 || inpos >= len(in) {
+		return nil, errors.New("bp32/NewBlockReader: no compressed data")
+	}
+
+	length := int(in[inpos])
+	if length < 0 || length%DefaultBlockSize != 0 {
+		return nil, errors.New("bp32/NewBlockReader: invalid length. Data may be corrupted.")
+	}
+
+	return &BlockReader{
+		in:     in,
+		blocks: length / DefaultBlockSize,
+		block:  -1,
+		next:   inpos + 1,
+	}, nil
+}
+
+// Len returns the number of blocks
+func (this *BlockReader) Len() int {
+	return this.blocks
+}
+
+// Index returns the index of the current block
+func (this *BlockReader) Index() int {
+	return this.block
+}
+
+// Next moves to the next block, and returns false if there is none, or if the data
+// is corrupted, as reported by Err
+func (this *BlockReader) Next() bool {
+	if this.err != nil || this.block+1 >= this.blocks {
+		return false
+	}
+
+	if this.next >= len(this.in) {
+		this.err = errors.New("bp32/BlockReader: block past the end of the data")
+		return false
+	}
+
+	header := this.in[this.next]
+	size := 1
+	for k := uint(0); k < 4; k++ {
+		mbits := width(header, k)
+		if mbits > 32 {
+			this.err = errors.New("bp32/BlockReader: invalid bit width. Data may be corrupted.")
+			return false
+		}
+		size += mbits
+	}
+	if header&boundsFlag != 0 {
+		size += 2
+	}
+
+	if size > len(this.in)-this.next {
+		this.err = errors.New("bp32/BlockReader: block past the end of the data")
+		return false
+	}
+
+	this.block++
+	this.pos, this.next = this.next, this.next+size
+
+	return true
+}
+
+// Err returns the error that stopped Next, if any
+func (this *BlockReader) Err() error {
+	return this.err
+}
+
+// width returns the bit width of the k-th group of 32 integers of the block with header,
+// the first one sharing its byte with the flag of the bounds
+func width(header int32, k uint) int {
+	if k == 0 {
+		return int((header >> 24) & 0x7F)
+	}
+
+	return int((header >> (24 - 8*k)) & 0xFF)
+}
+
+// Bounds returns the minimum and maximum integers of the current block, without
+// decoding it, and false if they were not written or if Next was not called
+func (this *BlockReader) Bounds() (min, max int32, ok bool) {
+	if this.block < 0 || this.in[this.pos]&boundsFlag == 0 {
+		return 0, 0, false
+	}
+
+	return this.in[this.pos+1], this.in[this.pos+2], true
+}
+
+// Decode writes the 128 integers of the current block to out, starting at outpos
+func (this *BlockReader) Decode(out []int32, outpos int) (err error) {
+	defer encoding.Recover("bp32/BlockReader.Decode", &err)
+
+	if this.block < 0 {
+		return errors.New("bp32/BlockReader.Decode: Next was not called")
+	}
+
+	header := this.in[this.pos]
+	inpos := this.pos + 1
+	if header&boundsFlag != 0 {
+		inpos += 2
+	}
+
+	for k := uint(0); k < 4; k++ {
+		mbits := width(header, k)
+		if mbits > 32 {
+			return errors.New("bp32/BlockReader.Decode: invalid bit width. Data may be corrupted.")
+		}
+		if err := bitpacking.FastUnpack(this.in, inpos, out, outpos+32*int(k), mbits); err != nil {
+			return errors.New("bp32/BlockReader.Decode: " + err.Error())
+		}
+		inpos += mbits
+	}
+
+	return nil
+}
@@ -14,10 +14,15 @@
 // through vectorization Software: Practice & Experience
 // http://onlinelibrary.wiley.com/doi/10.1002/spe.2203/abstract or
 //	http://arxiv.org/abs/1209.2137
+// The header of each 128-integer block holds the bit widths of its four groups of
+// 32 integers. If its most significant bit is set, it is followed by the minimum and
+// maximum integers of the block, so that a BlockReader can skip the blocks whose
+// integers do not matter, such as in block-max WAND query processing.
 package bp32
 
 import (
 	"errors"
+	"math"
 
 	"github.com/dataence/encoding"
 	"github.com/dataence/encoding/bitpacking"
@@ -26,9 +31,13 @@ import (
 
 const (
 	DefaultBlockSize = 128
+
+	// Flag set in the header of the blocks followed by their minimum and maximum
+	boundsFlag = math.MinInt32
 )
 
 type BP32 struct {
+	bounds bool
 }
 
 var _ encoding.Integer = (*BP32)(nil)
@@ -37,6 +46,12 @@ func New() encoding.Integer {
 	return &BP32{}
 }
 
+// NewWithBounds returns a codec writing the minimum and maximum integers of each block
+// after its header. The blocks written by either codec are decoded by both.
+func NewWithBounds() encoding.Integer {
+	return &BP32{bounds: true}
+}
+
 func (this *BP32) Compress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
 
 	inlength = encoding.FloorBy(inlength, DefaultBlockSize)
@@ -60,6 +75,13 @@ func (this *BP32) Compress(in []int32, inpos *cursor.Cursor, inlength int, out [
 
 		out[tmpoutpos] = (mbits1 << 24) | (mbits2 << 16) | (mbits3 << 8) | mbits4
 		tmpoutpos += 1
+
+		if this.bounds {
+			out[tmpoutpos-1] |= boundsFlag
+			out[tmpoutpos], out[tmpoutpos+1] = bounds(in[s : s+DefaultBlockSize])
+			tmpoutpos += 2
+		}
+
 		bitpacking.FastPackWithoutMask(in, s, out, tmpoutpos, int(mbits1))
 		tmpoutpos += int(mbits1)
 		bitpacking.FastPackWithoutMask(in, s+32, out, tmpoutpos, int(mbits2))
@@ -76,24 +98,32 @@ func (this *BP32) Compress(in []int32, inpos *cursor.Cursor, inlength int, out [
 	return nil
 }
 
-func (this *BP32) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+func (this *BP32) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) (err error) {
 	if inlength == 0 {
 		return errors.New("BP32/Uncompress: Length is 0. No work done.")
 	}
 
+	defer encoding.Recover("BP32/Uncompress", &err)
+
 	outlength := int(in[inpos.Get()])
+	if outlength < 0 || outlength > len(out)-outpos.Get() {
+		return errors.New("BP32/Uncompress: invalid length. Data may be corrupted.")
+	}
 	inpos.Increment()
 
 	tmpinpos := inpos.Get()
 
 	for s := outpos.Get(); s < outpos.Get()+outlength; s += 32 * 4 {
 		tmp := in[tmpinpos]
-		mbits1 := tmp >> 24
+		mbits1 := (tmp >> 24) & 0x7F
 		mbits2 := (tmp >> 16) & 0xFF
 		mbits3 := (tmp >> 8) & 0xFF
 		mbits4 := (tmp) & 0xFF
 
 		tmpinpos += 1
+		if tmp&boundsFlag != 0 {
+			tmpinpos += 2
+		}
 
 		bitpacking.FastUnpack(in, tmpinpos, out, s, int(mbits1))
 		tmpinpos += int(mbits1)
@@ -113,3 +143,18 @@ func (this *BP32) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out
 
 	return nil
 }
+
+// bounds returns the minimum and maximum integers of block
+func bounds(block []int32) (min, max int32) {
+	min, max = block[0], block[0]
+	for _, v := range block[1:] {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+
+	return min, max
+}
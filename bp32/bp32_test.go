@@ -8,8 +8,11 @@ package bp32
 
 import (
 	"log"
+	"math"
+	"math/rand"
 	"testing"
 
+	"github.com/dataence/encoding"
 	"github.com/dataence/encoding/benchtools"
 	"github.com/dataence/encoding/cursor"
 	"github.com/dataence/encoding/generators"
@@ -31,6 +34,121 @@ func TestCodec(t *testing.T) {
 	benchtools.TestCodec(New(), data, sizes)
 }
 
+func TestCodecWithBounds(t *testing.T) {
+	sizes := []int{128, 128 * 10, 128 * 100, 128 * 1000}
+	benchtools.TestCodec(NewWithBounds(), data, sizes)
+}
+
+func TestBlockReader(t *testing.T) {
+	// Term frequencies: mostly small, with a few large ones
+	r := rand.New(rand.NewSource(1))
+	in := make([]int32, 128*50)
+	for i := range in {
+		in[i] = 1 + r.Int31n(4)
+		if r.Intn(500) == 0 {
+			in[i] = r.Int31n(1 << 20)
+		}
+	}
+
+	for _, codec := range []encoding.Integer{New(), NewWithBounds()} {
+		_, out, err := benchtools.Compress(codec, in, len(in))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Both codecs decode either format
+		for _, decoder := range []encoding.Integer{New(), NewWithBounds()} {
+			recovered := make([]int32, len(in))
+			if err := decoder.Uncompress(out, cursor.New(), len(out), recovered, cursor.New()); err != nil {
+				t.Fatal(err)
+			}
+			for i := range in {
+				if recovered[i] != in[i] {
+					t.Fatalf("bp32/TestBlockReader: integer %d is %d, expected %d", i, recovered[i], in[i])
+				}
+			}
+		}
+
+		reader, err := NewBlockReader(out, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reader.Len() != len(in)/DefaultBlockSize {
+			t.Fatalf("bp32/TestBlockReader: %d blocks, expected %d", reader.Len(), len(in)/DefaultBlockSize)
+		}
+
+		// Only the blocks holding a large integer are decoded
+		block := make([]int32, DefaultBlockSize)
+		for reader.Next() {
+			s := reader.Index() * DefaultBlockSize
+
+			min, max, ok := reader.Bounds()
+			if ok != codec.(*BP32).bounds {
+				t.Fatalf("bp32/TestBlockReader: bounds reported %t", ok)
+			}
+			if !ok {
+				min, max = math.MinInt32, math.MaxInt32
+			}
+
+			expectedMin, expectedMax := bounds(in[s : s+DefaultBlockSize])
+			if ok && (min != expectedMin || max != expectedMax) {
+				t.Fatalf("bp32/TestBlockReader: block %d has bounds [%d, %d], expected [%d, %d]", reader.Index(), min, max, expectedMin, expectedMax)
+			}
+
+			if max < 5 {
+				continue
+			}
+
+			if err := reader.Decode(block, 0); err != nil {
+				t.Fatal(err)
+			}
+			for i, v := range block {
+				if v != in[s+i] {
+					t.Fatalf("bp32/TestBlockReader: integer %d is %d, expected %d", s+i, v, in[s+i])
+				}
+			}
+		}
+
+		if reader.Err() != nil || reader.Index() != reader.Len()-1 {
+			t.Fatalf("bp32/TestBlockReader: stopped at block %d of %d, %v", reader.Index(), reader.Len(), reader.Err())
+		}
+
+		// Truncated data
+		reader, err = NewBlockReader(out[:len(out)/2], 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for reader.Next() {
+		}
+		if reader.Err() == nil {
+			t.Fatalf("bp32/TestBlockReader: no error for truncated data")
+		}
+
+		// Before Next, there is no current block
+		reader, err = NewBlockReader(out, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, _, ok := reader.Bounds(); ok {
+			t.Fatalf("bp32/TestBlockReader: bounds reported before Next")
+		}
+		if err := reader.Decode(block, 0); err == nil {
+			t.Fatalf("bp32/TestBlockReader: no error for Decode before Next")
+		}
+
+		// A width above 32 in the header of the first block
+		corrupted := append([]int32{}, out...)
+		corrupted[1] |= 0x21 << 16
+		reader, err = NewBlockReader(corrupted, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reader.Next() || reader.Err() == nil {
+			t.Fatalf("bp32/TestBlockReader: no error for an invalid bit width")
+		}
+	}
+}
+
 // go test -bench=Decode
 func BenchmarkDecode(b *testing.B) {
 	b.StopTimer()
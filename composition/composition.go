@@ -4,6 +4,12 @@
  *
  */
 
+// Package composition compresses integers with two codecs, the first one, usually block
+// based, compressing as many of them as possible, and the second one the remaining ones.
+// When both are delta codecs, Compress starts both of them from 0, which is the format
+// of the data compressed before CompressFrom existed, while CompressFrom starts the
+// second one from the last integer of the first one, so that the differences form a
+// single chain across chunks and across the codecs.
 package composition
 
 import (
@@ -18,7 +24,7 @@ type Composition struct {
 	f2 encoding.Integer
 }
 
-var _ encoding.Integer = (*Composition)(nil)
+var _ encoding.DeltaInteger = (*Composition)(nil)
 
 func New(f1 encoding.Integer, f2 encoding.Integer) encoding.Integer {
 	return &Composition{
@@ -27,24 +33,76 @@ func New(f1 encoding.Integer, f2 encoding.Integer) encoding.Integer {
 	}
 }
 
+// Compress compresses as many integers as possible with f1, and the remaining ones with
+// f2. If both codecs are delta codecs, they both start from 0, as before CompressFrom
+// existed, so that the data compressed before can still be read with Uncompress.
 func (this *Composition) Compress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
 	if inlength == 0 {
 		return errors.New("composition/Compress: inlength = 0. No work done.")
 	}
 
+	return this.compress(0, false, in, inpos, inlength, out, outpos)
+}
+
+// CompressFrom requires both codecs to be delta codecs. f1 starts from initoffset, and f2
+// from the last integer compressed by f1, if any. The data must be read with
+// UncompressFrom.
+func (this *Composition) CompressFrom(initoffset int32, in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("composition/CompressFrom: inlength = 0. No work done.")
+	}
+
+	if !this.isDelta() {
+		return errors.New("composition/CompressFrom: codecs are not delta codecs")
+	}
+
+	return this.compress(initoffset, true, in, inpos, inlength, out, outpos)
+}
+
+// compress starts f2 from the last integer compressed by f1 if chain is true, and from
+// initoffset otherwise
+func (this *Composition) compress(initoffset int32, chain bool, in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
 	init := inpos.Get()
-	this.f1.Compress(in, inpos, inlength, out, outpos)
-	if outpos.Get() == 0 {
-		out[0] = 0
+	initout := outpos.Get()
+	delta := this.isDelta()
+
+	var err error
+	if delta {
+		err = this.f1.(encoding.DeltaInteger).CompressFrom(initoffset, in, inpos, inlength, out, outpos)
+	} else {
+		err = this.f1.Compress(in, inpos, inlength, out, outpos)
+	}
+
+	// The error of f1 is ignored if it compressed nothing, e.g. there are fewer integers
+	// than its block size, as f2 then compresses all the integers
+	if err != nil && inpos.Get() != init {
+		return err
+	}
+
+	// f1 wrote nothing, so an empty block is written for f1.Uncompress to read
+	if outpos.Get() == initout {
+		out[initout] = 0
 		outpos.Increment()
 	}
 	//log.Printf("composition/Compress: f1 inpos = %d, outpos = %d, inlength = %d\n", inpos.Get(), outpos.Get(), inlength)
 
+	// f1 may have compressed everything, e.g. when it is not block based
 	inlength -= inpos.Get() - init
-	this.f2.Compress(in, inpos, inlength, out, outpos)
+	if inlength == 0 {
+		return nil
+	}
+
+	if delta {
+		if chain && inpos.Get() > init {
+			initoffset = in[inpos.Get()-1]
+		}
+		err = this.f2.(encoding.DeltaInteger).CompressFrom(initoffset, in, inpos, inlength, out, outpos)
+	} else {
+		err = this.f2.Compress(in, inpos, inlength, out, outpos)
+	}
 	//log.Printf("composition/Compress: f2 inpos = %d, outpos = %d, inlength = %d\n", inpos.Get(), outpos.Get(), inlength)
 
-	return nil
+	return err
 }
 
 func (this *Composition) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
@@ -52,12 +110,72 @@ func (this *Composition) Uncompress(in []int32, inpos *cursor.Cursor, inlength i
 		return errors.New("composition/Uncompress: inlength = 0. No work done.")
 	}
 
+	return this.uncompress(0, false, in, inpos, inlength, out, outpos)
+}
+
+// UncompressFrom requires both codecs to be delta codecs, and reads the data written by
+// CompressFrom
+func (this *Composition) UncompressFrom(initoffset int32, in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("composition/UncompressFrom: inlength = 0. No work done.")
+	}
+
+	if !this.isDelta() {
+		return errors.New("composition/UncompressFrom: codecs are not delta codecs")
+	}
+
+	return this.uncompress(initoffset, true, in, inpos, inlength, out, outpos)
+}
+
+// uncompress starts f2 from the last integer decoded by f1 if chain is true, and from
+// initoffset otherwise
+func (this *Composition) uncompress(initoffset int32, chain bool, in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
 	init := inpos.Get()
-	this.f1.Uncompress(in, inpos, inlength, out, outpos)
+	initout := outpos.Get()
+	delta := this.isDelta()
+
+	var err error
+	if delta {
+		err = this.f1.(encoding.DeltaInteger).UncompressFrom(initoffset, in, inpos, inlength, out, outpos)
+	} else {
+		err = this.f1.Uncompress(in, inpos, inlength, out, outpos)
+	}
+	if err != nil {
+		return err
+	}
 	//log.Printf("composition/Uncompress: f1 inpos = %d, outpos = %d, inlength = %d\n", inpos.Get(), outpos.Get(), inlength)
+
 	inlength -= inpos.Get() - init
-	this.f2.Uncompress(in, inpos, inlength, out, outpos)
+	if inlength == 0 {
+		return nil
+	}
+
+	if delta {
+		if chain && outpos.Get() > initout {
+			initoffset = out[outpos.Get()-1]
+		}
+		err = this.f2.(encoding.DeltaInteger).UncompressFrom(initoffset, in, inpos, inlength, out, outpos)
+	} else {
+		err = this.f2.Uncompress(in, inpos, inlength, out, outpos)
+	}
 	//log.Printf("composition/Uncompress: f2 inpos = %d, outpos = %d, inlength = %d\n", inpos.Get(), outpos.Get(), inlength)
 
-	return nil
+	return err
+}
+
+// isDelta returns true if both codecs are delta codecs, in which case the offset is
+// passed to them
+func (this *Composition) isDelta() bool {
+	return isDelta(this.f1) && isDelta(this.f2)
+}
+
+// isDelta returns true if codec supports an initial offset. A composition always has
+// the methods of a delta codec, but only supports an offset if its codecs do.
+func isDelta(codec encoding.Integer) bool {
+	if c, ok := codec.(*Composition); ok {
+		return c.isDelta()
+	}
+
+	_, ok := codec.(encoding.DeltaInteger)
+	return ok
 }
@@ -13,9 +13,11 @@ import (
 	"github.com/dataence/encoding"
 	"github.com/dataence/encoding/benchtools"
 	"github.com/dataence/encoding/bp32"
+	"github.com/dataence/encoding/cursor"
 	dbp32 "github.com/dataence/encoding/delta/bp32"
 	dvb "github.com/dataence/encoding/delta/variablebyte"
 	"github.com/dataence/encoding/generators"
+	"github.com/dataence/encoding/rle"
 	"github.com/dataence/encoding/variablebyte"
 )
 
@@ -40,3 +42,111 @@ func TestBP32andVariableByte(t *testing.T) {
 	sizes := []int{100, 100 * 10, 100 * 100, 100 * 1000, 100 * 10000}
 	benchtools.TestCodec(New(bp32.New(), variablebyte.New()), data, sizes)
 }
+
+func TestRLEandVariableByte(t *testing.T) {
+	sizes := []int{100, 100 * 10, 100 * 100, 100 * 1000, 100 * 10000}
+	benchtools.TestCodec(New(rle.New(), variablebyte.New()), data, sizes)
+}
+
+func TestDeltaCompressFrom(t *testing.T) {
+	// Consecutive chunks of the same array, each one starting from the previous one,
+	// some of them smaller than a block
+	chunks := []int{100, 1000, 128 * 10, 1, 5000}
+	codec := New(dbp32.New(), dvb.New()).(encoding.DeltaInteger)
+	out := make([]int32, 20000)
+	inpos, outpos := cursor.New(), cursor.New()
+	lengths := make([]int, len(chunks))
+	for k, chunk := range chunks {
+		initoffset := int32(0)
+		if inpos.Get() > 0 {
+			initoffset = data[inpos.Get()-1]
+		}
+
+		start := outpos.Get()
+		if err := codec.CompressFrom(initoffset, data, inpos, chunk, out, outpos); err != nil {
+			t.Fatal(err)
+		}
+		lengths[k] = outpos.Get() - start
+	}
+
+	recov := make([]int32, inpos.Get())
+	inpos, recovpos := cursor.New(), cursor.New()
+	for _, length := range lengths {
+		initoffset := int32(0)
+		if recovpos.Get() > 0 {
+			initoffset = recov[recovpos.Get()-1]
+		}
+
+		if err := codec.UncompressFrom(initoffset, out, inpos, length, recov, recovpos); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i := range recov {
+		if recov[i] != data[i] {
+			t.Fatalf("composition/TestDeltaCompressFrom: Problem recovering. index = %d, in = %d, recovered = %d", i, data[i], recov[i])
+		}
+	}
+
+	if err := New(bp32.New(), variablebyte.New()).(encoding.DeltaInteger).CompressFrom(0, data, cursor.New(), 100, out, cursor.New()); err == nil {
+		t.Fatal("composition/TestDeltaCompressFrom: expected error for codecs that are not delta codecs")
+	}
+}
+
+func TestDeltaFormat(t *testing.T) {
+	n := 1000
+	codec := New(dbp32.New(), dvb.New())
+
+	// Compress starts both delta codecs from 0, so the data is that of f1 followed by
+	// that of f2, as before CompressFrom existed
+	out := make([]int32, 2*n)
+	outpos := cursor.New()
+	if err := codec.Compress(data, cursor.New(), n, out, outpos); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := make([]int32, 2*n)
+	inpos, expos := cursor.New(), cursor.New()
+	dbp32.New().Compress(data, inpos, n, expected, expos)
+	dvb.New().Compress(data, inpos, n-inpos.Get(), expected, expos)
+	checkFormat(t, out[:outpos.Get()], expected[:expos.Get()])
+
+	// CompressFrom starts f2 from the last integer of f1, so the chain is not broken
+	initoffset := data[n]
+	outpos = cursor.New()
+	if err := codec.(encoding.DeltaInteger).CompressFrom(initoffset, data[n+1:], cursor.New(), n, out, outpos); err != nil {
+		t.Fatal(err)
+	}
+
+	inpos, expos = cursor.New(), cursor.New()
+	dbp32.New().(encoding.DeltaInteger).CompressFrom(initoffset, data[n+1:], inpos, n, expected, expos)
+	dvb.New().(encoding.DeltaInteger).CompressFrom(data[n+inpos.Get()], data[n+1:], inpos, n-inpos.Get(), expected, expos)
+	checkFormat(t, out[:outpos.Get()], expected[:expos.Get()])
+}
+
+func checkFormat(t *testing.T, out, expected []int32) {
+	if len(out) != len(expected) {
+		t.Fatalf("composition/TestDeltaFormat: compressed to %d integers, expected %d", len(out), len(expected))
+	}
+	for i := range out {
+		if out[i] != expected[i] {
+			t.Fatalf("composition/TestDeltaFormat: integer %d is %d, expected %d", i, out[i], expected[i])
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	// Corrupted data of f2 must be reported
+	out := make([]int32, 2000)
+	outpos := cursor.New()
+	codec := New(bp32.New(), variablebyte.New())
+	if err := codec.Compress(data, cursor.New(), 1000, out, outpos); err != nil {
+		t.Fatal(err)
+	}
+	out[outpos.Get()-1] = 0
+
+	recov := make([]int32, 1000)
+	if err := codec.Uncompress(out, cursor.New(), outpos.Get(), recov, cursor.New()); err == nil {
+		t.Fatal("composition/TestErrors: no error for corrupted data")
+	}
+}
@@ -22,13 +22,17 @@ const (
 type BP32 struct {
 }
 
-var _ encoding.Integer = (*BP32)(nil)
+var _ encoding.DeltaInteger = (*BP32)(nil)
 
 func New() encoding.Integer {
 	return &BP32{}
 }
 
 func (this *BP32) Compress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	return this.CompressFrom(0, in, inpos, inlength, out, outpos)
+}
+
+func (this *BP32) CompressFrom(initoffset int32, in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
 	//log.Printf("bp32/Compress: before inlength = %d\n", inlength)
 
 	inlength = encoding.FloorBy(inlength, DefaultBlockSize)
@@ -43,7 +47,6 @@ func (this *BP32) Compress(in []int32, inpos *cursor.Cursor, inlength int, out [
 	outpos.Increment()
 
 	tmpoutpos := outpos.Get()
-	initoffset := int32(0)
 	s := inpos.Get()
 	finalinpos := s + inlength
 
@@ -93,18 +96,26 @@ func (this *BP32) Compress(in []int32, inpos *cursor.Cursor, inlength int, out [
 }
 
 func (this *BP32) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	return this.UncompressFrom(0, in, inpos, inlength, out, outpos)
+}
+
+func (this *BP32) UncompressFrom(initoffset int32, in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) (err error) {
 	if inlength == 0 {
 		return errors.New("BP32/Uncompress: Length is 0. No work done.")
 	}
 
-	outlength := in[inpos.Get()]
+	defer encoding.Recover("BP32/Uncompress", &err)
+
+	outlength := int(in[inpos.Get()])
+	if outlength < 0 || outlength > len(out)-outpos.Get() {
+		return errors.New("BP32/Uncompress: invalid length. Data may be corrupted.")
+	}
 	inpos.Increment()
 
 	tmpinpos := inpos.Get()
-	initoffset := int32(0)
 
 	//log.Printf("bp32/Uncompress: outlength = %d, inpos = %d, outpos = %d\n", outlength, inpos.Get(), outpos.Get())
-	for s := outpos.Get(); s < outpos.Get()+int(outlength); s += 32 * 4 {
+	for s := outpos.Get(); s < outpos.Get()+outlength; s += 32 * 4 {
 		tmp := in[tmpinpos]
 		mbits1 := tmp >> 24
 		mbits2 := (tmp >> 16) & 0xFF
@@ -135,7 +146,7 @@ func (this *BP32) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out
 		//log.Printf("bp32/Uncompress: out = %v\n", out)
 	}
 
-	outpos.Add(int(outlength))
+	outpos.Add(outlength)
 	inpos.Set(tmpinpos)
 
 	return nil
@@ -42,7 +42,7 @@ type FastPFOR struct {
 	freqs        []int32
 }
 
-var _ encoding.Integer = (*FastPFOR)(nil)
+var _ encoding.DeltaInteger = (*FastPFOR)(nil)
 
 func New() encoding.Integer {
 	f := &FastPFOR{
@@ -60,6 +60,10 @@ func New() encoding.Integer {
 }
 
 func (this *FastPFOR) Compress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	return this.CompressFrom(0, in, inpos, inlength, out, outpos)
+}
+
+func (this *FastPFOR) CompressFrom(initoffset int32, in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
 	inlength = encoding.FloorBy(inlength, DefaultBlockSize)
 
 	if inlength == 0 {
@@ -69,7 +73,8 @@ func (this *FastPFOR) Compress(in []int32, inpos *cursor.Cursor, inlength int, o
 	out[outpos.Get()] = int32(inlength)
 	outpos.Increment()
 
-	initoffset := cursor.New()
+	offset := cursor.New()
+	offset.Set(int(initoffset))
 
 	copy(this.dataPointers, zeroDataPointers)
 	copy(this.freqs, zeroFreqs)
@@ -79,7 +84,7 @@ func (this *FastPFOR) Compress(in []int32, inpos *cursor.Cursor, inlength int, o
 	for inpos.Get() != finalInpos {
 		thissize := int(math.Min(float64(this.pageSize), float64(finalInpos-inpos.Get())))
 
-		if err := this.encodePage(in, inpos, thissize, out, outpos, initoffset); err != nil {
+		if err := this.encodePage(in, inpos, thissize, out, outpos, offset); err != nil {
 			return errors.New("fastpfor/Compress: " + err.Error())
 		}
 	}
@@ -88,14 +93,24 @@ func (this *FastPFOR) Compress(in []int32, inpos *cursor.Cursor, inlength int, o
 }
 
 func (this *FastPFOR) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	return this.UncompressFrom(0, in, inpos, inlength, out, outpos)
+}
+
+func (this *FastPFOR) UncompressFrom(initoffset int32, in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) (err error) {
 	if inlength == 0 {
 		return errors.New("fastpfor/Uncompress: inlength = 0. No work done.")
 	}
 
+	defer encoding.Recover("fastpfor/Uncompress", &err)
+
 	mynvalue := in[inpos.Get()]
 	inpos.Increment()
+	if mynvalue < 0 || mynvalue%DefaultBlockSize != 0 || int(mynvalue) > len(out)-outpos.Get() {
+		return errors.New("fastpfor/Uncompress: invalid length. Data may be corrupted.")
+	}
 
-	initoffset := cursor.New()
+	offset := cursor.New()
+	offset.Set(int(initoffset))
 
 	copy(this.dataPointers, zeroDataPointers)
 
@@ -103,7 +118,7 @@ func (this *FastPFOR) Uncompress(in []int32, inpos *cursor.Cursor, inlength int,
 	for outpos.Get() != finalout {
 		thissize := int(math.Min(float64(this.pageSize), float64(finalout-outpos.Get())))
 
-		if err := this.decodePage(in, inpos, out, outpos, thissize, initoffset); err != nil {
+		if err := this.decodePage(in, inpos, out, outpos, thissize, offset); err != nil {
 			return errors.New("fastpfor/Uncompress: " + err.Error())
 		}
 	}
@@ -261,6 +276,9 @@ func (this *FastPFOR) decodePage(in []int32, inpos *cursor.Cursor, out []int32,
 	inpos.Increment()
 
 	inexcept := initpos + wheremeta
+	if inexcept <= initpos {
+		return errors.New("invalid position of the metadata")
+	}
 	bytesize := in[inexcept]
 	inexcept += 1
 
@@ -277,6 +295,9 @@ func (this *FastPFOR) decodePage(in []int32, inpos *cursor.Cursor, out []int32,
 		if bitmap&(1<<uint32(k-1)) != 0 {
 			size := in[inexcept]
 			inexcept += 1
+			if size < 0 || int(size) > thissize {
+				return errors.New("invalid number of exceptions")
+			}
 
 			if int32(len(this.dataToBePacked[k])) < size {
 				this.dataToBePacked[k] = make([]int32, encoding.CeilBy(int(size), 32))
@@ -349,6 +370,10 @@ func (this *FastPFOR) decodePage(in []int32, inpos *cursor.Cursor, out []int32,
 		tmpoutpos += DefaultBlockSize
 	}
 
+	if int(tmpoutpos) == outpos.Get() {
+		return errors.New("no integers decoded")
+	}
+
 	outpos.Set(int(tmpoutpos))
 	inpos.Set(int(inexcept))
 
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package simple8b compresses the differences between successive int64s with Simple-8b.
+// The integers must be sorted, and the first one must not be negative, so that every
+// difference is within [0, 2^60).
+package simple8b
+
+import (
+	"errors"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/cursor"
+	"github.com/dataence/encoding/simple8b"
+)
+
+// Simple8b codec structure: this is not thread-safe (need one per thread)
+type Simple8b struct {
+	// Working area
+	buf []uint64
+}
+
+var _ encoding.Integer64 = (*Simple8b)(nil)
+
+func New() encoding.Integer64 {
+	return &Simple8b{}
+}
+
+// Compress stores the differences between successive integers, the input must be sorted
+// and its first integer must not be negative
+func (this *Simple8b) Compress(in []int64, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("delta/simple8b/Compress: inlength = 0. No work done.")
+	}
+
+	this.buf = simple8b.Grow(this.buf, inlength)
+	offset := int64(0)
+	for i, v := range in[inpos.Get() : inpos.Get()+inlength] {
+		this.buf[i] = uint64(v - offset)
+		offset = v
+	}
+
+	out[outpos.Get()] = int32(inlength)
+	tmpoutpos, err := simple8b.EncodeAll(this.buf, out, outpos.Get()+1)
+	if err != nil {
+		return errors.New("delta/simple8b/Compress: " + err.Error())
+	}
+
+	inpos.Add(inlength)
+	outpos.Set(tmpoutpos)
+
+	return nil
+}
+
+func (this *Simple8b) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int64, outpos *cursor.Cursor) (err error) {
+	if inlength == 0 {
+		return errors.New("delta/simple8b/Uncompress: inlength = 0. No work done.")
+	}
+
+	defer encoding.Recover("delta/simple8b/Uncompress", &err)
+
+	outlength := int(in[inpos.Get()])
+	if outlength < 0 || outlength > len(out)-outpos.Get() {
+		return errors.New("delta/simple8b/Uncompress: invalid length. Data may be corrupted.")
+	}
+	this.buf = simple8b.Grow(this.buf, outlength)
+
+	tmpinpos, err := simple8b.DecodeAll(in, inpos.Get()+1, this.buf)
+	if err != nil {
+		return errors.New("delta/simple8b/Uncompress: " + err.Error())
+	}
+
+	tmpoutpos := outpos.Get()
+	offset := int64(0)
+	for i, v := range this.buf {
+		offset += int64(v)
+		out[tmpoutpos+i] = offset
+	}
+
+	inpos.Set(tmpinpos)
+	outpos.Add(outlength)
+
+	return nil
+}
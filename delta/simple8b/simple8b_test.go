@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package simple8b
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dataence/encoding/cursor"
+)
+
+func roundTrip(t *testing.T, in []int64) int {
+	out := make([]int32, 2*len(in)+1)
+	outpos := cursor.New()
+	if err := New().Compress(in, cursor.New(), len(in), out, outpos); err != nil {
+		t.Fatal(err)
+	}
+
+	recov := make([]int64, len(in))
+	if err := New().Uncompress(out, cursor.New(), outpos.Get(), recov, cursor.New()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range in {
+		if recov[i] != in[i] {
+			t.Fatalf("delta/simple8b/roundTrip: Problem recovering. index = %d, in = %d, recovered = %d, original length = %d", i, in[i], recov[i], len(in))
+		}
+	}
+
+	return outpos.Get()
+}
+
+func TestCodec(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 100, 1000, 100000} {
+		in := make([]int64, n)
+		v := int64(1) << 40
+		for i := range in {
+			v += r.Int63n(1 << uint(r.Intn(30)))
+			in[i] = v
+		}
+
+		roundTrip(t, in)
+	}
+}
+
+func TestConsecutive(t *testing.T) {
+	in := make([]int64, 240*100)
+	for i := range in {
+		in[i] = int64(i) + 1
+	}
+
+	// Every word holds a run of 240 ones
+	if size := roundTrip(t, in); size != 1+2*100 {
+		t.Fatalf("delta/simple8b/TestConsecutive: compressed to %d int32s", size)
+	}
+}
+
+func TestUnsorted(t *testing.T) {
+	in := []int64{1, 5, 3}
+	out := make([]int32, 100)
+	if err := New().Compress(in, cursor.New(), len(in), out, cursor.New()); err == nil {
+		t.Fatal("delta/simple8b/TestUnsorted: expected error for unsorted input")
+	}
+}
+
+// go test -bench=Decode
+func BenchmarkDecode(b *testing.B) {
+	b.StopTimer()
+	length := 128 * 1024
+	r := rand.New(rand.NewSource(1))
+	data := make([]int64, length)
+	v := int64(0)
+	for i := range data {
+		v += r.Int63n(1 << 10)
+		data[i] = v
+	}
+	compdata := make([]int32, 2*length+1)
+	recov := make([]int64, length)
+	inpos := cursor.New()
+	outpos := cursor.New()
+	codec := New()
+	codec.Compress(data, inpos, len(data), compdata, outpos)
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		newinpos := cursor.New()
+		newoutpos := cursor.New()
+		codec.Uncompress(compdata, newinpos, outpos.Get()-newinpos.Get(), recov, newoutpos)
+	}
+}
@@ -17,13 +17,17 @@ import (
 type VariableByte struct {
 }
 
-var _ encoding.Integer = (*VariableByte)(nil)
+var _ encoding.DeltaInteger = (*VariableByte)(nil)
 
 func New() encoding.Integer {
 	return &VariableByte{}
 }
 
 func (this *VariableByte) Compress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	return this.CompressFrom(0, in, inpos, inlength, out, outpos)
+}
+
+func (this *VariableByte) CompressFrom(initoffset int32, in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
 	if inlength == 0 {
 		return errors.New("variablebyte/Compress: inlength = 0. No work done.")
 	}
@@ -31,7 +35,6 @@ func (this *VariableByte) Compress(in []int32, inpos *cursor.Cursor, inlength in
 	//fmt.Printf("variablebyte/Compress: after inlength = %d\n", inlength)
 
 	buf := bytebuffer.NewByteBuffer(inlength * 8)
-	initoffset := int32(0)
 
 	tmpinpos := inpos.Get()
 	for _, v := range in[tmpinpos : tmpinpos+inlength] {
@@ -67,17 +70,22 @@ func (this *VariableByte) Compress(in []int32, inpos *cursor.Cursor, inlength in
 }
 
 func (this *VariableByte) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	return this.UncompressFrom(0, in, inpos, inlength, out, outpos)
+}
+
+func (this *VariableByte) UncompressFrom(initoffset int32, in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) (err error) {
 	if inlength == 0 {
 		return errors.New("variablebyte/Uncompress: inlength = 0. No work done.")
 	}
 
+	defer encoding.Recover("variablebyte/Uncompress", &err)
+
 	//fmt.Printf("variablebyte/Uncompress: after inlength = %d\n", inlength)
 
 	s := uint(0)
 	p := inpos.Get()
 	finalp := inpos.Get() + inlength
 	tmpoutpos := outpos.Get()
-	initoffset := int32(0)
 	v := int32(0)
 	shift := uint(0)
 
@@ -100,10 +108,10 @@ func (this *VariableByte) Uncompress(in []int32, inpos *cursor.Cursor, inlength
 		} else {
 			shift += 7
 		}
-
-		outpos.Set(tmpoutpos)
-		inpos.Add(inlength)
 	}
 
+	outpos.Set(tmpoutpos)
+	inpos.Add(inlength)
+
 	return nil
 }
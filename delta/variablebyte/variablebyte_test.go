@@ -10,6 +10,7 @@ import (
 	"log"
 	"testing"
 
+	"github.com/dataence/encoding"
 	"github.com/dataence/encoding/benchtools"
 	"github.com/dataence/encoding/generators"
 )
@@ -29,3 +30,7 @@ func TestCodec(t *testing.T) {
 	sizes := []int{128, 128 * 10, 128 * 100, 128 * 1000, 128 * 10000}
 	benchtools.TestCodec(New(), data, sizes)
 }
+
+func TestCompressFrom(t *testing.T) {
+	benchtools.TestDeltaCodec(New().(encoding.DeltaInteger), data[:128*1000], 128*10)
+}
@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package deltadelta is an implementation of the delta-of-delta integer compression
+// algorithm in Go, as used by Gorilla for timestamps.
+// The second order differences are zigzag encoded, then bit packed in 128-integer
+// blocks. The integers that do not fill a complete block are variable byte encoded.
+// It is mostly suitable for near-regular series, such as timestamps, where the
+// successive differences are almost constant.
+// For details, please see
+// Tuomas Pelkonen et al., Gorilla: A Fast, Scalable, In-Memory Time Series Database,
+// VLDB 2015 http://www.vldb.org/pvldb/vol8/p1816-teller.pdf
+package deltadelta
+
+import (
+	"errors"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/bitpacking"
+	"github.com/dataence/encoding/cursor"
+)
+
+const (
+	DefaultBlockSize = 128
+)
+
+type DeltaDelta struct {
+}
+
+var _ encoding.Integer = (*DeltaDelta)(nil)
+
+func New() encoding.Integer {
+	return &DeltaDelta{}
+}
+
+func (this *DeltaDelta) Compress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("deltadelta/Compress: inlength = 0. No work done.")
+	}
+
+	tmpoutpos := outpos.Get()
+	s := inpos.Get()
+
+	out[tmpoutpos] = int32(inlength)
+	out[tmpoutpos+1] = in[s]
+	tmpoutpos += 2
+
+	prev := in[s]
+	prevdelta := int32(0)
+	s += 1
+	finalinpos := inpos.Get() + inlength
+
+	var dods [DefaultBlockSize]int32
+
+	for ; s+DefaultBlockSize <= finalinpos; s += DefaultBlockSize {
+		for i, v := range in[s : s+DefaultBlockSize] {
+			delta := v - prev
+			dod := delta - prevdelta
+			dods[i] = (dod << 1) ^ (dod >> 31)
+			prev = v
+			prevdelta = delta
+		}
+
+		mbits1 := encoding.MaxBits(dods[0:32])
+		mbits2 := encoding.MaxBits(dods[32:64])
+		mbits3 := encoding.MaxBits(dods[64:96])
+		mbits4 := encoding.MaxBits(dods[96:128])
+
+		out[tmpoutpos] = (mbits1 << 24) | (mbits2 << 16) | (mbits3 << 8) | mbits4
+		tmpoutpos += 1
+
+		bitpacking.FastPackWithoutMask(dods[:], 0, out, tmpoutpos, int(mbits1))
+		tmpoutpos += int(mbits1)
+		bitpacking.FastPackWithoutMask(dods[:], 32, out, tmpoutpos, int(mbits2))
+		tmpoutpos += int(mbits2)
+		bitpacking.FastPackWithoutMask(dods[:], 64, out, tmpoutpos, int(mbits3))
+		tmpoutpos += int(mbits3)
+		bitpacking.FastPackWithoutMask(dods[:], 96, out, tmpoutpos, int(mbits4))
+		tmpoutpos += int(mbits4)
+	}
+
+	// The remaining integers are variable byte encoded, the first byte being the most
+	// significant byte of each int32
+	shift := uint(24)
+	for _, v := range in[s:finalinpos] {
+		delta := v - prev
+		dod := delta - prevdelta
+		val := uint32((dod << 1) ^ (dod >> 31))
+		prev = v
+		prevdelta = delta
+
+		for {
+			c := byte(val & 127)
+			val >>= 7
+			if val != 0 {
+				c |= 128
+			}
+
+			if shift == 24 {
+				out[tmpoutpos] = 0
+			}
+
+			out[tmpoutpos] |= int32(uint32(c) << shift)
+			if shift == 0 {
+				shift = 24
+				tmpoutpos += 1
+			} else {
+				shift -= 8
+			}
+
+			if val == 0 {
+				break
+			}
+		}
+	}
+
+	if shift != 24 {
+		tmpoutpos += 1
+	}
+
+	inpos.Add(inlength)
+	outpos.Set(tmpoutpos)
+
+	return nil
+}
+
+func (this *DeltaDelta) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) (err error) {
+	if inlength == 0 {
+		return errors.New("deltadelta/Uncompress: inlength = 0. No work done.")
+	}
+
+	defer encoding.Recover("deltadelta/Uncompress", &err)
+
+	tmpinpos := inpos.Get()
+	outlength := int(in[tmpinpos])
+	prev := in[tmpinpos+1]
+	prevdelta := int32(0)
+	tmpinpos += 2
+
+	s := outpos.Get()
+	finaloutpos := s + outlength
+	out[s] = prev
+	s += 1
+
+	var dods [DefaultBlockSize]int32
+
+	for ; s+DefaultBlockSize <= finaloutpos; s += DefaultBlockSize {
+		tmp := in[tmpinpos]
+		tmpinpos += 1
+
+		for k := 0; k < 4; k++ {
+			mbits := int((tmp >> uint(24-8*k)) & 0xFF)
+			if mbits > 32 {
+				return errors.New("deltadelta/Uncompress: invalid bit width. Data may be corrupted.")
+			}
+
+			if err := bitpacking.FastUnpack(in, tmpinpos, dods[:], 32*k, mbits); err != nil {
+				return errors.New("deltadelta/Uncompress: " + err.Error())
+			}
+			tmpinpos += mbits
+		}
+
+		for i, v := range dods {
+			prevdelta += int32(uint32(v)>>1) ^ ((v << 31) >> 31)
+			prev += prevdelta
+			out[s+i] = prev
+		}
+	}
+
+	shift := uint(24)
+	for ; s < finaloutpos; s++ {
+		v := int32(0)
+		for n := uint(0); ; n += 7 {
+			c := (in[tmpinpos] >> shift) & 0xFF
+			if shift == 0 {
+				shift = 24
+				tmpinpos += 1
+			} else {
+				shift -= 8
+			}
+
+			v |= (c & 127) << n
+			if c&128 == 0 {
+				break
+			}
+		}
+
+		prevdelta += int32(uint32(v)>>1) ^ ((v << 31) >> 31)
+		prev += prevdelta
+		out[s] = prev
+	}
+
+	if shift != 24 {
+		tmpinpos += 1
+	}
+
+	outpos.Add(outlength)
+	inpos.Set(tmpinpos)
+
+	return nil
+}
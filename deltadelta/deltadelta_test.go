@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package deltadelta
+
+import (
+	"log"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/dataence/encoding/benchtools"
+	"github.com/dataence/encoding/cursor"
+	"github.com/dataence/encoding/generators"
+)
+
+var (
+	data []int32
+	size int = 128000
+)
+
+func init() {
+	log.Printf("deltadelta/init: generating %d int32s\n", size)
+	data = generators.GenerateClustered(size, size*2)
+	log.Printf("deltadelta/init: generated %d integers for test", size)
+}
+
+func TestCodec(t *testing.T) {
+	sizes := []int{1, 2, 100, 128, 129, 128 * 10, 128*100 + 77, 128 * 1000}
+	benchtools.TestCodec(New(), data, sizes)
+}
+
+func TestTimestamps(t *testing.T) {
+	// Every 10 seconds, with a bit of jitter once in a while
+	r := rand.New(rand.NewSource(1))
+	in := make([]int32, 128*100+33)
+	ts := int32(1400000000)
+	for i := range in {
+		ts += 10
+		if r.Intn(100) == 0 {
+			ts += r.Int31n(3) - 1
+		}
+		in[i] = ts
+	}
+
+	benchtools.TestCodec(New(), in, []int{len(in)})
+
+	_, out, err := benchtools.Compress(New(), in, len(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bits := float64(len(out)*32) / float64(len(in)); bits > 1 {
+		t.Fatalf("deltadelta/TestTimestamps: %f bits per timestamp", bits)
+	}
+}
+
+func TestExtremes(t *testing.T) {
+	in := []int32{math.MaxInt32, math.MinInt32, 0, math.MaxInt32, -1, math.MinInt32, 5, 5, 5}
+	benchtools.TestCodec(New(), in, []int{len(in)})
+}
+
+func TestCorrupted(t *testing.T) {
+	n := 1 + 128*4
+	_, out, err := benchtools.Compress(New(), data[:n], n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The header of the first block, after the length and the first integer, holds a
+	// width above 32, in its first byte or in another one
+	for _, header := range []int32{-1 << 24, 33 << 16, 0xFF} {
+		corrupted := append([]int32{}, out...)
+		corrupted[2] = header
+
+		recov := make([]int32, n)
+		if err := New().Uncompress(corrupted, cursor.New(), len(corrupted), recov, cursor.New()); err == nil {
+			t.Fatalf("deltadelta/TestCorrupted: no error for the header %x", uint32(header))
+		}
+	}
+}
+
+// go test -bench=Decode
+func BenchmarkDecode(b *testing.B) {
+	b.StopTimer()
+	length := 128 * 1024
+	data := generators.GenerateClustered(length, 1<<24)
+	compdata := make([]int32, 2*length)
+	recov := make([]int32, length)
+	inpos := cursor.New()
+	outpos := cursor.New()
+	codec := New()
+	codec.Compress(data, inpos, len(data), compdata, outpos)
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		newinpos := cursor.New()
+		newoutpos := cursor.New()
+		codec.Uncompress(compdata, newinpos, outpos.Get()-newinpos.Get(), recov, newoutpos)
+	}
+}
@@ -0,0 +1,220 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package dictionary is an implementation of the dictionary encoding integer
+// compression algorithm in Go.
+// The integers are split in pages. For each page, the distinct values are sorted
+// and delta compressed, and every integer is replaced by its index in the sorted
+// dictionary, the indices being compressed with FastPFOR. When the dictionary does
+// not pay off, the page is stored as is.
+// It is mostly suitable for arrays of large values with a low number of distinct
+// values, such as IP addresses or identifiers.
+package dictionary
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/composition"
+	"github.com/dataence/encoding/cursor"
+	dbp32 "github.com/dataence/encoding/delta/bp32"
+	dvb "github.com/dataence/encoding/delta/variablebyte"
+	"github.com/dataence/encoding/fastpfor"
+	"github.com/dataence/encoding/variablebyte"
+)
+
+const (
+	DefaultPageSize = 65536
+
+	// Page types, stored in the first int32 of each page
+	TypePlain      = 0
+	TypeDictionary = 1
+)
+
+// Dictionary codec structure: this is not thread-safe (need one per thread)
+type Dictionary struct {
+	values  encoding.Integer
+	indices encoding.Integer
+
+	// Working area
+	dict   []int32
+	idx    []int32
+	buf    []int32
+	lookup map[int32]int32
+}
+
+var _ encoding.Integer = (*Dictionary)(nil)
+
+func New() encoding.Integer {
+	return &Dictionary{
+		values:  composition.New(dbp32.New(), dvb.New()),
+		indices: composition.New(fastpfor.New(), variablebyte.New()),
+		dict:    make([]int32, DefaultPageSize),
+		idx:     make([]int32, DefaultPageSize),
+		buf:     make([]int32, 2*DefaultPageSize+1024),
+		lookup:  make(map[int32]int32),
+	}
+}
+
+func (this *Dictionary) Compress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("dictionary/Compress: inlength = 0. No work done.")
+	}
+
+	out[outpos.Get()] = int32(inlength)
+	outpos.Increment()
+
+	s := inpos.Get()
+	finalinpos := s + inlength
+
+	for ; s < finalinpos; s += DefaultPageSize {
+		e := s + DefaultPageSize
+		if e > finalinpos {
+			e = finalinpos
+		}
+
+		if err := this.encodePage(in[s:e], out, outpos); err != nil {
+			return errors.New("dictionary/Compress: " + err.Error())
+		}
+	}
+
+	inpos.Add(inlength)
+
+	return nil
+}
+
+func (this *Dictionary) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) (err error) {
+	if inlength == 0 {
+		return errors.New("dictionary/Uncompress: inlength = 0. No work done.")
+	}
+
+	defer encoding.Recover("dictionary/Uncompress", &err)
+
+	outlength := int(in[inpos.Get()])
+	inpos.Increment()
+
+	s := outpos.Get()
+	finaloutpos := s + outlength
+
+	for ; s < finaloutpos; s += DefaultPageSize {
+		e := s + DefaultPageSize
+		if e > finaloutpos {
+			e = finaloutpos
+		}
+
+		if err := this.decodePage(in, inpos, out[s:e]); err != nil {
+			return errors.New("dictionary/Uncompress: " + err.Error())
+		}
+	}
+
+	outpos.Add(outlength)
+
+	return nil
+}
+
+// encodePage writes the page either as [TypeDictionary, dictionary length, indices
+// length, dictionary, indices] or as [TypePlain, integers], whichever is smaller.
+func (this *Dictionary) encodePage(page []int32, out []int32, outpos *cursor.Cursor) error {
+	for k := range this.lookup {
+		delete(this.lookup, k)
+	}
+
+	dict := this.dict[:0]
+	for _, v := range page {
+		if _, ok := this.lookup[v]; !ok {
+			this.lookup[v] = 0
+			dict = append(dict, v)
+		}
+	}
+
+	// A dictionary with as many values as the page can never be smaller
+	if len(dict) < len(page) {
+		sort.Sort(int32s(dict))
+		for i, v := range dict {
+			this.lookup[v] = int32(i)
+		}
+
+		idx := this.idx[:len(page)]
+		for i, v := range page {
+			idx[i] = this.lookup[v]
+		}
+
+		// The sub-codecs are run on slices so that they always start at position 0
+		dictpos := cursor.New()
+		if err := this.values.Compress(dict, cursor.New(), len(dict), this.buf, dictpos); err != nil {
+			return err
+		}
+
+		idxpos := cursor.New()
+		if err := this.indices.Compress(idx, cursor.New(), len(idx), this.buf[dictpos.Get():], idxpos); err != nil {
+			return err
+		}
+
+		if size := 3 + dictpos.Get() + idxpos.Get(); size < 1+len(page) {
+			tmpoutpos := outpos.Get()
+			out[tmpoutpos] = TypeDictionary
+			out[tmpoutpos+1] = int32(dictpos.Get())
+			out[tmpoutpos+2] = int32(idxpos.Get())
+			copy(out[tmpoutpos+3:], this.buf[:dictpos.Get()+idxpos.Get()])
+			outpos.Add(size)
+			return nil
+		}
+	}
+
+	tmpoutpos := outpos.Get()
+	out[tmpoutpos] = TypePlain
+	copy(out[tmpoutpos+1:], page)
+	outpos.Add(1 + len(page))
+
+	return nil
+}
+
+func (this *Dictionary) decodePage(in []int32, inpos *cursor.Cursor, page []int32) error {
+	tmpinpos := inpos.Get()
+
+	switch in[tmpinpos] {
+	case TypePlain:
+		copy(page, in[tmpinpos+1:tmpinpos+1+len(page)])
+		inpos.Add(1 + len(page))
+
+	case TypeDictionary:
+		dictlength := int(in[tmpinpos+1])
+		idxlength := int(in[tmpinpos+2])
+		tmpinpos += 3
+
+		dictpos := cursor.New()
+		if err := this.values.Uncompress(in[tmpinpos:tmpinpos+dictlength], cursor.New(), dictlength, this.dict, dictpos); err != nil {
+			return err
+		}
+		dict := this.dict[:dictpos.Get()]
+		tmpinpos += dictlength
+
+		if err := this.indices.Uncompress(in[tmpinpos:tmpinpos+idxlength], cursor.New(), idxlength, page, cursor.New()); err != nil {
+			return err
+		}
+
+		for i, v := range page {
+			if v < 0 || int(v) >= len(dict) {
+				return errors.New("index out of dictionary range")
+			}
+			page[i] = dict[v]
+		}
+
+		inpos.Set(tmpinpos + idxlength)
+
+	default:
+		return errors.New("unknown page type")
+	}
+
+	return nil
+}
+
+type int32s []int32
+
+func (this int32s) Len() int           { return len(this) }
+func (this int32s) Less(i, j int) bool { return this[i] < this[j] }
+func (this int32s) Swap(i, j int)      { this[i], this[j] = this[j], this[i] }
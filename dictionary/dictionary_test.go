@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package dictionary
+
+import (
+	"log"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/dataence/encoding/benchtools"
+	"github.com/dataence/encoding/cursor"
+)
+
+var (
+	data []int32
+	size int = 128000
+)
+
+func init() {
+	log.Printf("dictionary/init: generating %d int32s\n", size)
+	data = generateRepeated(size, 1000)
+	log.Printf("dictionary/init: generated %d integers for test", size)
+}
+
+// generateRepeated returns n integers drawn from a set of k large random values
+func generateRepeated(n, k int) []int32 {
+	r := rand.New(rand.NewSource(1))
+	values := make([]int32, k)
+	for i := range values {
+		values[i] = int32(r.Uint32())
+	}
+
+	in := make([]int32, n)
+	for i := range in {
+		in[i] = values[r.Intn(k)]
+	}
+
+	return in
+}
+
+func TestCodec(t *testing.T) {
+	sizes := []int{100, 128, 128 * 10, 128 * 100, 128 * 1000}
+	benchtools.TestCodec(New(), data, sizes)
+}
+
+func TestRepeated(t *testing.T) {
+	_, out, err := benchtools.Compress(New(), data, len(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 1000 distinct values need 10 bits per index
+	if bits := float64(len(out)*32) / float64(len(data)); bits > 12 {
+		t.Fatalf("dictionary/TestRepeated: %f bits per integer", bits)
+	}
+}
+
+func TestFallback(t *testing.T) {
+	// Multiplying by an odd constant is a bijection, so all the values are distinct
+	in := make([]int32, size)
+	for i := range in {
+		in[i] = int32(uint32(i) * 2654435761)
+	}
+
+	benchtools.TestCodec(New(), in, []int{100, 128 * 1000})
+
+	_, out, err := benchtools.Compress(New(), in, len(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// All the pages are plain: one header per page and one for the length
+	if expected := len(in) + (len(in)+DefaultPageSize-1)/DefaultPageSize + 1; len(out) != expected {
+		t.Fatalf("dictionary/TestFallback: compressed to %d int32s, expected %d", len(out), expected)
+	}
+}
+
+func TestSmall(t *testing.T) {
+	in := []int32{math.MaxInt32, math.MinInt32, 7, 7, 7, 7, -1, 7, math.MaxInt32, 7}
+	benchtools.TestPrefixes(New(), in)
+}
+
+// go test -bench=Decode
+func BenchmarkDecode(b *testing.B) {
+	b.StopTimer()
+	length := 128 * 1024
+	data := generateRepeated(length, 4096)
+	compdata := make([]int32, 2*length)
+	recov := make([]int32, length)
+	inpos := cursor.New()
+	outpos := cursor.New()
+	codec := New()
+	codec.Compress(data, inpos, len(data), compdata, outpos)
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		newinpos := cursor.New()
+		newoutpos := cursor.New()
+		codec.Uncompress(compdata, newinpos, outpos.Get()-newinpos.Get(), recov, newoutpos)
+	}
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package eliasdelta is an implementation of the Elias delta integer compression
+// algorithm in Go.
+// Each integer v is stored as v+1, whose number of bits L is written with the Elias
+// gamma code, followed by its L-1 lowest bits. The integers are considered unsigned.
+// It is mostly suitable for skewed distributions with a long tail, where the gamma
+// code of the large integers would be too long.
+// For details, please see
+// Peter Elias, Universal codeword sets and representations of the integers,
+// IEEE Transactions on Information Theory 21(2), 1975
+// http://dx.doi.org/10.1109/TIT.1975.1055349
+package eliasdelta
+
+import (
+	"errors"
+	"math/bits"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/bitio"
+	"github.com/dataence/encoding/cursor"
+	"github.com/dataence/encoding/eliasgamma"
+)
+
+type EliasDelta struct {
+}
+
+var _ encoding.Integer = (*EliasDelta)(nil)
+
+func New() encoding.Integer {
+	return &EliasDelta{}
+}
+
+func (this *EliasDelta) Compress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("eliasdelta/Compress: inlength = 0. No work done.")
+	}
+
+	out[outpos.Get()] = int32(inlength)
+	w := bitio.NewWriter(out, outpos.Get()+1)
+
+	for _, v := range in[inpos.Get() : inpos.Get()+inlength] {
+		Write(w, uint64(uint32(v))+1)
+	}
+
+	w.Flush()
+
+	inpos.Add(inlength)
+	outpos.Set(w.Pos())
+
+	return nil
+}
+
+func (this *EliasDelta) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) (err error) {
+	if inlength == 0 {
+		return errors.New("eliasdelta/Uncompress: inlength = 0. No work done.")
+	}
+
+	defer encoding.Recover("eliasdelta/Uncompress", &err)
+
+	outlength := int(in[inpos.Get()])
+	r := bitio.NewReader(in, inpos.Get()+1)
+
+	tmpoutpos := outpos.Get()
+	for i := tmpoutpos; i < tmpoutpos+outlength; i++ {
+		out[i] = int32(uint32(Read(r) - 1))
+	}
+
+	inpos.Set(r.Pos())
+	outpos.Add(outlength)
+
+	return nil
+}
+
+// Write writes the delta code of x, which must be at least 1
+func Write(w *bitio.Writer, x uint64) {
+	l := uint(bits.Len64(x))
+	eliasgamma.Write(w, uint64(l))
+	w.Write64(x, l-1)
+}
+
+// Read reads a delta code written by Write
+func Read(r *bitio.Reader) uint64 {
+	l := uint(eliasgamma.Read(r))
+	if l == 0 || l > 64 {
+		// Not a valid code
+		return 0
+	}
+
+	return 1<<(l-1) | r.Read64(l-1)
+}
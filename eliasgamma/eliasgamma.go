@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package eliasgamma is an implementation of the Elias gamma integer compression
+// algorithm in Go.
+// Each integer v is stored as v+1, whose number of bits L is written in unary,
+// followed by its L-1 lowest bits. The integers are considered unsigned.
+// It is mostly suitable for very skewed distributions where most integers are tiny.
+// For details, please see
+// Peter Elias, Universal codeword sets and representations of the integers,
+// IEEE Transactions on Information Theory 21(2), 1975
+// http://dx.doi.org/10.1109/TIT.1975.1055349
+package eliasgamma
+
+import (
+	"errors"
+	"math/bits"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/bitio"
+	"github.com/dataence/encoding/cursor"
+)
+
+type EliasGamma struct {
+}
+
+var _ encoding.Integer = (*EliasGamma)(nil)
+
+func New() encoding.Integer {
+	return &EliasGamma{}
+}
+
+func (this *EliasGamma) Compress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("eliasgamma/Compress: inlength = 0. No work done.")
+	}
+
+	out[outpos.Get()] = int32(inlength)
+	w := bitio.NewWriter(out, outpos.Get()+1)
+
+	for _, v := range in[inpos.Get() : inpos.Get()+inlength] {
+		Write(w, uint64(uint32(v))+1)
+	}
+
+	w.Flush()
+
+	inpos.Add(inlength)
+	outpos.Set(w.Pos())
+
+	return nil
+}
+
+func (this *EliasGamma) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) (err error) {
+	if inlength == 0 {
+		return errors.New("eliasgamma/Uncompress: inlength = 0. No work done.")
+	}
+
+	defer encoding.Recover("eliasgamma/Uncompress", &err)
+
+	outlength := int(in[inpos.Get()])
+	r := bitio.NewReader(in, inpos.Get()+1)
+
+	tmpoutpos := outpos.Get()
+	for i := tmpoutpos; i < tmpoutpos+outlength; i++ {
+		out[i] = int32(uint32(Read(r) - 1))
+	}
+
+	inpos.Set(r.Pos())
+	outpos.Add(outlength)
+
+	return nil
+}
+
+// Write writes the gamma code of x, which must be at least 1
+func Write(w *bitio.Writer, x uint64) {
+	l := uint(bits.Len64(x))
+	w.WriteUnary(uint64(l - 1))
+	w.Write64(x, l-1)
+}
+
+// Read reads a gamma code written by Write
+func Read(r *bitio.Reader) uint64 {
+	l := uint(r.ReadUnary()) + 1
+	if l > 64 {
+		// Not a valid code
+		return 0
+	}
+
+	return 1<<(l-1) | r.Read64(l-1)
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package eliasgamma
+
+import (
+	"log"
+	"math"
+	"math/bits"
+	"math/rand"
+	"testing"
+
+	"github.com/dataence/encoding/benchtools"
+	"github.com/dataence/encoding/bitio"
+	"github.com/dataence/encoding/cursor"
+	"github.com/dataence/encoding/generators"
+)
+
+var (
+	data []int32
+	size int = 128000
+)
+
+func init() {
+	log.Printf("eliasgamma/init: generating %d int32s\n", size)
+	data = generators.GenerateClustered(size, size*2)
+	log.Printf("eliasgamma/init: generated %d integers for test", size)
+}
+
+func TestCodec(t *testing.T) {
+	sizes := []int{100, 128, 128 * 10, 128 * 100, 128 * 1000}
+	benchtools.TestCodec(New(), data, sizes)
+}
+
+func TestSkewed(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	in := make([]int32, size)
+	for i := range in {
+		in[i] = int32(r.ExpFloat64() * 2)
+	}
+
+	benchtools.TestCodec(New(), in, []int{len(in)})
+
+	_, out, err := benchtools.Compress(New(), in, len(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bits := float64(len(out)*32) / float64(len(in)); bits > 3.5 {
+		t.Fatalf("eliasgamma/TestSkewed: %f bits per integer", bits)
+	}
+}
+
+func TestExtremes(t *testing.T) {
+	in := []int32{0, 1, 2, 3, -1, math.MinInt32, math.MaxInt32, 0, 0, 1 << 20}
+	benchtools.TestPrefixes(New(), in)
+}
+
+func TestCodes(t *testing.T) {
+	// 1 is the shortest code, and 1<<32, that of -1, the longest one, with 65 bits.
+	// Each code is written after offset bits, so that it crosses word boundaries.
+	for _, x := range []uint64{1, 2, 3, 4, 1<<31 - 1, 1 << 31, 1<<32 - 1, 1 << 32} {
+		for offset := uint(0); offset < 64; offset++ {
+			out := make([]int32, 8)
+			w := bitio.NewWriter(out, 0)
+			w.Write64(1<<offset-1, offset)
+			Write(w, x)
+			w.Flush()
+
+			length := 2*bits.Len64(x) - 1
+			if total := int(offset) + length; w.Pos() != (total+31)/32 {
+				t.Fatalf("eliasgamma/TestCodes: code of %d after %d bits takes %d int32s, expected %d bits", x, offset, w.Pos(), total)
+			}
+
+			r := bitio.NewReader(out, 0)
+			if r.Read64(offset) != 1<<offset-1 {
+				t.Fatalf("eliasgamma/TestCodes: bits before the code of %d changed", x)
+			}
+			if v := Read(r); v != x {
+				t.Fatalf("eliasgamma/TestCodes: read %d after %d bits, expected %d", v, offset, x)
+			}
+		}
+	}
+}
+
+// go test -bench=Decode
+func BenchmarkDecode(b *testing.B) {
+	b.StopTimer()
+	length := 128 * 1024
+	data := generators.GenerateClustered(length, 1<<24)
+	compdata := make([]int32, 2*length)
+	recov := make([]int32, length)
+	inpos := cursor.New()
+	outpos := cursor.New()
+	codec := New()
+	codec.Compress(data, inpos, len(data), compdata, outpos)
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		newinpos := cursor.New()
+		newoutpos := cursor.New()
+		codec.Uncompress(compdata, newinpos, outpos.Get()-newinpos.Get(), recov, newoutpos)
+	}
+}
@@ -0,0 +1,26 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package encoding
+
+import (
+	"errors"
+	"runtime"
+)
+
+// Recover turns a runtime panic caused by corrupted compressed data, such as an index
+// out of range, into an error. It is deferred by the Uncompress methods, with prefix
+// being the name of the method and err its named result. Other panics are re-raised.
+func Recover(prefix string, err *error) {
+	if r := recover(); r != nil {
+		if re, ok := r.(runtime.Error); ok {
+			*err = errors.New(prefix + ": data may be corrupted, " + re.Error())
+			return
+		}
+
+		panic(r)
+	}
+}
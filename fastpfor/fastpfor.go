@@ -93,13 +93,18 @@ func (this *FastPFOR) Compress(in []int32, inpos *cursor.Cursor, inlength int, o
 	return nil
 }
 
-func (this *FastPFOR) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+func (this *FastPFOR) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) (err error) {
 	if inlength == 0 {
 		return errors.New("fastpfor/Uncompress: inlength = 0. No work done.")
 	}
 
+	defer encoding.Recover("fastpfor/Uncompress", &err)
+
 	mynvalue := in[inpos.Get()]
 	inpos.Increment()
+	if mynvalue < 0 || int(mynvalue) > len(out)-outpos.Get() {
+		return errors.New("fastpfor/Uncompress: invalid length. Data may be corrupted.")
+	}
 
 	copy(this.dataPointers, zeroDataPointers)
 
@@ -259,6 +264,9 @@ func (this *FastPFOR) decodePage(in []int32, inpos *cursor.Cursor, out []int32,
 		if bitmap&(1<<uint32(k-1)) != 0 {
 			size := in[inexcept]
 			inexcept += 1
+			if size < 0 || int(size) > thissize {
+				return errors.New("invalid number of exceptions")
+			}
 
 			if int32(len(this.dataToBePacked[k])) < size {
 				this.dataToBePacked[k] = make([]int32, encoding.CeilBy(int(size), 32))
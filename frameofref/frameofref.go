@@ -0,0 +1,195 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package frameofref is an implementation of the frame-of-reference (FOR) integer
+// compression algorithm in Go using 128-integer blocks.
+// The minimum of each block is stored in the block header and subtracted from every
+// integer of the block before the results are bit packed, 32 integers at a time.
+// It is mostly suitable for unsorted arrays whose values are locally close to each
+// other, such as timestamps or identifiers, no matter how large they are.
+// For details, please see
+// Jonathan Goldstein, Raghu Ramakrishnan and Uri Shaft, Compressing relations
+// and indexes, ICDE 1998 http://dx.doi.org/10.1109/ICDE.1998.655800
+package frameofref
+
+import (
+	"errors"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/bitpacking"
+	"github.com/dataence/encoding/cursor"
+)
+
+const (
+	DefaultBlockSize = 128
+)
+
+type FOR struct {
+}
+
+var _ encoding.Integer = (*FOR)(nil)
+
+func New() encoding.Integer {
+	return &FOR{}
+}
+
+func (this *FOR) Compress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	inlength = encoding.FloorBy(inlength, DefaultBlockSize)
+
+	if inlength == 0 {
+		return errors.New("frameofref/Compress: block size less than 128. No work done.")
+	}
+
+	out[outpos.Get()] = int32(inlength)
+	outpos.Increment()
+
+	tmpoutpos := outpos.Get()
+	s := inpos.Get()
+	finalinpos := s + inlength
+
+	for ; s < finalinpos; s += DefaultBlockSize {
+		tmpoutpos = EncodeBlock(in, s, out, tmpoutpos)
+	}
+
+	inpos.Add(inlength)
+	outpos.Set(tmpoutpos)
+
+	return nil
+}
+
+func (this *FOR) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) (err error) {
+	if inlength == 0 {
+		return errors.New("frameofref/Uncompress: Length is 0. No work done.")
+	}
+
+	defer encoding.Recover("frameofref/Uncompress", &err)
+
+	outlength := int(in[inpos.Get()])
+	inpos.Increment()
+
+	tmpinpos := inpos.Get()
+	s := outpos.Get()
+	finaloutpos := s + outlength
+
+	for ; s < finaloutpos; s += DefaultBlockSize {
+		if tmpinpos, err = DecodeBlock(in, tmpinpos, out, s); err != nil {
+			return errors.New("frameofref/Uncompress: " + err.Error())
+		}
+	}
+
+	outpos.Add(outlength)
+	inpos.Set(tmpinpos)
+
+	return nil
+}
+
+// EncodeBlock writes the block of the 128 integers of in starting at inpos to out,
+// starting at outpos, and returns the position following it
+func EncodeBlock(in []int32, inpos int, out []int32, outpos int) int {
+	base := in[inpos]
+	for _, v := range in[inpos+1 : inpos+DefaultBlockSize] {
+		if v < base {
+			base = v
+		}
+	}
+
+	// The offsets are computed modulo 2^32, so they always fit in 32 bits
+	var offsets [DefaultBlockSize]int32
+	for i, v := range in[inpos : inpos+DefaultBlockSize] {
+		offsets[i] = v - base
+	}
+
+	mbits1 := encoding.MaxBits(offsets[0:32])
+	mbits2 := encoding.MaxBits(offsets[32:64])
+	mbits3 := encoding.MaxBits(offsets[64:96])
+	mbits4 := encoding.MaxBits(offsets[96:128])
+
+	out[outpos] = base
+	out[outpos+1] = (mbits1 << 24) | (mbits2 << 16) | (mbits3 << 8) | mbits4
+	outpos += 2
+
+	bitpacking.FastPackWithoutMask(offsets[:], 0, out, outpos, int(mbits1))
+	outpos += int(mbits1)
+	bitpacking.FastPackWithoutMask(offsets[:], 32, out, outpos, int(mbits2))
+	outpos += int(mbits2)
+	bitpacking.FastPackWithoutMask(offsets[:], 64, out, outpos, int(mbits3))
+	outpos += int(mbits3)
+	bitpacking.FastPackWithoutMask(offsets[:], 96, out, outpos, int(mbits4))
+	outpos += int(mbits4)
+
+	return outpos
+}
+
+// widths returns the bit widths of the four groups of 32 integers of a block, read from
+// its header
+func widths(header int32) ([4]int, error) {
+	var w [4]int
+	for k := range w {
+		w[k] = int((header >> uint(24-8*k)) & 0xFF)
+		if w[k] > 32 {
+			return w, errors.New("invalid bit width. Data may be corrupted.")
+		}
+	}
+
+	return w, nil
+}
+
+// DecodeBlock writes the 128 integers of the block of in starting at inpos to out,
+// starting at outpos, and returns the position following the block
+func DecodeBlock(in []int32, inpos int, out []int32, outpos int) (int, error) {
+	base := in[inpos]
+	w, err := widths(in[inpos+1])
+	if err != nil {
+		return inpos, err
+	}
+
+	inpos += 2
+
+	for k, mbits := range w {
+		if err := bitpacking.FastUnpack(in, inpos, out, outpos+32*k, mbits); err != nil {
+			return inpos, err
+		}
+		inpos += mbits
+	}
+
+	for i := outpos; i < outpos+DefaultBlockSize; i++ {
+		out[i] += base
+	}
+
+	return inpos, nil
+}
+
+// Get returns the i-th integer of the block of in starting at inpos, without
+// decoding the rest of the block
+func Get(in []int32, inpos int, i int) (int32, error) {
+	base := in[inpos]
+	w, err := widths(in[inpos+1])
+	if err != nil {
+		return 0, err
+	}
+	inpos += 2
+
+	k := i / 32
+	for _, mbits := range w[:k] {
+		inpos += mbits
+	}
+
+	b := uint(w[k])
+	if b == 0 {
+		return base, nil
+	}
+
+	bit := uint(i%32) * b
+	p := inpos + int(bit/32)
+	shift := bit % 32
+
+	v := uint64(uint32(in[p])) >> shift
+	if shift+b > 32 {
+		v |= uint64(uint32(in[p+1])) << (32 - shift)
+	}
+
+	return int32(uint32(v)&uint32(1<<b-1)) + base, nil
+}
@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package frameofref
+
+import (
+	"log"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/dataence/encoding/benchtools"
+	"github.com/dataence/encoding/bp32"
+	"github.com/dataence/encoding/cursor"
+	"github.com/dataence/encoding/generators"
+)
+
+var (
+	data []int32
+	size int = 128000
+)
+
+func init() {
+	log.Printf("frameofref/init: generating %d int32s\n", size)
+	data = generators.GenerateClustered(size, size*2)
+	log.Printf("frameofref/init: generated %d integers for test", size)
+}
+
+func TestCodec(t *testing.T) {
+	sizes := []int{128, 128 * 10, 128 * 100, 128 * 1000}
+	benchtools.TestCodec(New(), data, sizes)
+}
+
+func TestLocallyNarrow(t *testing.T) {
+	// Epoch seconds, each within a minute of its neighbours
+	r := rand.New(rand.NewSource(1))
+	in := make([]int32, 128*100)
+	for i := range in {
+		in[i] = 1400000000 + int32(i/128)*3600 + r.Int31n(60)
+	}
+
+	benchtools.TestCodec(New(), in, []int{len(in)})
+
+	_, out, err := benchtools.Compress(New(), in, len(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, bpout, err := benchtools.Compress(bp32.New(), in, len(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(out)*4 > len(bpout) {
+		t.Fatalf("frameofref/TestLocallyNarrow: compressed to %d int32s, bp32 compressed to %d", len(out), len(bpout))
+	}
+}
+
+func TestNegative(t *testing.T) {
+	in := make([]int32, 128*4)
+	for i := range in {
+		switch i % 4 {
+		case 0:
+			in[i] = int32(-i)
+		case 1:
+			in[i] = math.MinInt32 + int32(i)
+		case 2:
+			in[i] = math.MaxInt32 - int32(i)
+		default:
+			in[i] = int32(i)
+		}
+	}
+
+	benchtools.TestCodec(New(), in, []int{128, len(in)})
+}
+
+func TestGet(t *testing.T) {
+	in := make([]int32, 128*4)
+	for i := range in {
+		in[i] = 1400000000 + int32(i*i%1000)
+	}
+	in[5] = math.MinInt32
+	for i := 256; i < 384; i++ {
+		in[i] = -7
+	}
+
+	out := make([]int32, 2*len(in))
+	outpos := 0
+	for s := 0; s < len(in); s += DefaultBlockSize {
+		inpos := outpos
+		outpos = EncodeBlock(in, s, out, outpos)
+
+		for i := 0; i < DefaultBlockSize; i++ {
+			v, err := Get(out, inpos, i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v != in[s+i] {
+				t.Fatalf("frameofref/TestGet: integer %d is %d, expected %d", s+i, v, in[s+i])
+			}
+		}
+	}
+}
+
+func TestCorrupted(t *testing.T) {
+	n := 128 * 4
+	_, out, err := benchtools.Compress(New(), data[:n], n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The header of the first block follows the length and the base
+	for _, header := range []int32{-1 << 24, 33 << 16, 0xFF} {
+		corrupted := append([]int32{}, out...)
+		corrupted[2] = header
+
+		if _, _, err := benchtools.Uncompress(New(), corrupted, n); err == nil {
+			t.Fatalf("frameofref/TestCorrupted: no error for header %#x", uint32(header))
+		}
+		if _, err := DecodeBlock(corrupted, 1, make([]int32, DefaultBlockSize), 0); err == nil {
+			t.Fatalf("frameofref/TestCorrupted: DecodeBlock: no error for header %#x", uint32(header))
+		}
+		if _, err := Get(corrupted, 1, DefaultBlockSize-1); err == nil {
+			t.Fatalf("frameofref/TestCorrupted: Get: no error for header %#x", uint32(header))
+		}
+	}
+}
+
+// go test -bench=Decode
+func BenchmarkDecode(b *testing.B) {
+	b.StopTimer()
+	length := 128 * 1024
+	data := generators.GenerateClustered(length, 1<<24)
+	compdata := make([]int32, 2*length)
+	recov := make([]int32, length)
+	inpos := cursor.New()
+	outpos := cursor.New()
+	codec := New()
+	codec.Compress(data, inpos, len(data), compdata, outpos)
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		newinpos := cursor.New()
+		newoutpos := cursor.New()
+		codec.Uncompress(compdata, newinpos, outpos.Get()-newinpos.Get(), recov, newoutpos)
+	}
+}
@@ -0,0 +1,13 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package fuzz holds the fuzz targets run against every codec of the library.
+//
+//	go test ./fuzz -fuzz=FuzzRoundTrip
+//	go test ./fuzz -fuzz=FuzzDecodeGarbage
+//
+// Without -fuzz, the targets only run their seed corpus, as part of the tests.
+package fuzz
@@ -0,0 +1,401 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package fuzz
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/adaptive"
+	"github.com/dataence/encoding/auto"
+	"github.com/dataence/encoding/bp32"
+	"github.com/dataence/encoding/composition"
+	"github.com/dataence/encoding/cursor"
+	dbp32 "github.com/dataence/encoding/delta/bp32"
+	dfastpfor "github.com/dataence/encoding/delta/fastpfor"
+	ds8b "github.com/dataence/encoding/delta/simple8b"
+	dvb "github.com/dataence/encoding/delta/variablebyte"
+	"github.com/dataence/encoding/deltadelta"
+	"github.com/dataence/encoding/dictionary"
+	"github.com/dataence/encoding/eliasdelta"
+	"github.com/dataence/encoding/eliasgamma"
+	"github.com/dataence/encoding/fastpfor"
+	"github.com/dataence/encoding/frameofref"
+	"github.com/dataence/encoding/interpolative"
+	"github.com/dataence/encoding/pef"
+	"github.com/dataence/encoding/rice"
+	"github.com/dataence/encoding/rle"
+	"github.com/dataence/encoding/simple16"
+	"github.com/dataence/encoding/simple8b"
+	"github.com/dataence/encoding/simplepfor"
+	"github.com/dataence/encoding/variablebyte"
+	"github.com/dataence/encoding/xorfloat"
+	zbp32 "github.com/dataence/encoding/zigzag/bp32"
+	zfastpfor "github.com/dataence/encoding/zigzag/fastpfor"
+	"github.com/dataence/encoding/zigzag/nodelta"
+	zs8b "github.com/dataence/encoding/zigzag/simple8b"
+	zvb "github.com/dataence/encoding/zigzag/variablebyte"
+)
+
+// Restrictions on the input of a codec
+const (
+	anyInput = iota
+	sortedInput
+	increasingInput
+	smallInput
+)
+
+var codecs = []struct {
+	name  string
+	new   func() encoding.Integer
+	input int
+}{
+	{"bp32", bp32.New, anyInput},
+	{"bp32/bounds", bp32.NewWithBounds, anyInput},
+	{"fastpfor", fastpfor.New, anyInput},
+	{"variablebyte", variablebyte.New, anyInput},
+	{"delta/bp32", dbp32.New, anyInput},
+	{"delta/fastpfor", dfastpfor.New, anyInput},
+	{"delta/variablebyte", dvb.New, anyInput},
+	{"zigzag/bp32", zbp32.New, anyInput},
+	{"zigzag/fastpfor", zfastpfor.New, anyInput},
+	{"zigzag/variablebyte", zvb.New, anyInput},
+	{"zigzag/nodelta/bp32", nodelta.NewBP32, anyInput},
+	{"zigzag/nodelta/fastpfor", nodelta.NewFastPFOR, anyInput},
+	{"zigzag/nodelta/variablebyte", nodelta.NewVariableByte, anyInput},
+	{"frameofref", frameofref.New, anyInput},
+	{"deltadelta", deltadelta.New, anyInput},
+	{"rle", rle.New, anyInput},
+	{"dictionary", dictionary.New, anyInput},
+	{"rice", rice.New, anyInput},
+	{"eliasgamma", eliasgamma.New, anyInput},
+	{"eliasdelta", eliasdelta.New, anyInput},
+	{"simple16", simple16.New, smallInput},
+	{"simplepfor", simplepfor.New, anyInput},
+	{"simplepforvb", func() encoding.Integer { return simplepfor.NewWithExceptionCoder(simplepfor.VariableByte) }, anyInput},
+	{"pef", pef.New, sortedInput},
+	{"interpolative", interpolative.New, increasingInput},
+	{"bp32+variablebyte", func() encoding.Integer { return composition.New(bp32.New(), variablebyte.New()) }, anyInput},
+	{"fastpfor+variablebyte", func() encoding.Integer { return composition.New(fastpfor.New(), variablebyte.New()) }, anyInput},
+	{"delta/bp32+delta/variablebyte", func() encoding.Integer { return composition.New(dbp32.New(), dvb.New()) }, anyInput},
+	{"delta/fastpfor+delta/variablebyte", func() encoding.Integer { return composition.New(dfastpfor.New(), dvb.New()) }, anyInput},
+	{"zigzag/bp32+zigzag/variablebyte", func() encoding.Integer { return composition.New(zbp32.New(), zvb.New()) }, anyInput},
+	{"frameofref+variablebyte", func() encoding.Integer { return composition.New(frameofref.New(), variablebyte.New()) }, anyInput},
+	{"simplepfor+variablebyte", func() encoding.Integer { return composition.New(simplepfor.New(), variablebyte.New()) }, anyInput},
+	{"rle+variablebyte", func() encoding.Integer { return composition.New(rle.New(), variablebyte.New()) }, anyInput},
+	{"auto", auto.New, anyInput},
+	{"adaptive", adaptive.New, anyInput},
+}
+
+var codecs64 = []struct {
+	name  string
+	new   func() encoding.Integer64
+	input int
+}{
+	{"simple8b", simple8b.New, smallInput},
+	{"delta/simple8b", ds8b.New, sortedInput},
+	{"zigzag/simple8b", zs8b.New, anyInput},
+}
+
+var floats64 = []struct {
+	name string
+	new  func() encoding.Float64
+}{
+	{"gorilla64", xorfloat.NewGorilla64},
+	{"chimp64", xorfloat.NewChimp64},
+}
+
+var floats32 = []struct {
+	name string
+	new  func() encoding.Float32
+}{
+	{"gorilla32", xorfloat.NewGorilla32},
+	{"chimp32", xorfloat.NewChimp32},
+}
+
+// seeds adds lengths around the block sizes, as well as the extreme int32s
+func seeds(f *testing.F) {
+	for _, n := range []int{0, 1, 2, 3, 31, 127, 128, 129, 255, 256, 300} {
+		data := make([]byte, 4*n)
+		for i := 0; i < n; i++ {
+			binary.LittleEndian.PutUint32(data[4*i:], uint32(i*i*2654435761))
+		}
+		f.Add(data, uint8(0))
+		f.Add(data, uint8(20))
+	}
+
+	for _, v := range []int32{math.MinInt32, math.MaxInt32, -1, 0} {
+		for _, n := range []int{1, 5, 128, 130} {
+			data := make([]byte, 4*n)
+			for i := 0; i < n; i++ {
+				binary.LittleEndian.PutUint32(data[4*i:], uint32(v))
+			}
+			f.Add(data, uint8(0))
+		}
+	}
+
+	// Alternating extremes, the worst case for all the delta codecs
+	data := make([]byte, 4*200)
+	for i := 0; i < 200; i++ {
+		v := int32(math.MaxInt32)
+		if i%2 == 1 {
+			v = math.MinInt32
+		}
+		binary.LittleEndian.PutUint32(data[4*i:], uint32(v))
+	}
+	f.Add(data, uint8(0))
+}
+
+// toInt32s reads the little endian int32s of data, shifted right by shift bits so
+// that the fuzzer also covers the narrow bit widths
+func toInt32s(data []byte, shift uint8) []int32 {
+	in := make([]int32, len(data)/4)
+	for i := range in {
+		in[i] = int32(binary.LittleEndian.Uint32(data[4*i:])) >> (shift % 33)
+	}
+	return in
+}
+
+func restrict(in []int32, input int) []int32 {
+	switch input {
+	case smallInput:
+		for i := range in {
+			in[i] &= simple16.MaxValue
+		}
+
+	case sortedInput, increasingInput:
+		sort.Sort(int32s(in))
+		if input == increasingInput && len(in) > 0 {
+			j := 1
+			for i := 1; i < len(in); i++ {
+				if in[i] != in[j-1] {
+					in[j] = in[i]
+					j++
+				}
+			}
+			in = in[:j]
+		}
+	}
+
+	return in
+}
+
+func restrict64(in []int32, input int) []int64 {
+	out := make([]int64, len(in))
+	for i, v := range in {
+		switch input {
+		case anyInput:
+			out[i] = int64(v) << 27
+		default:
+			out[i] = int64(uint32(v)) << 28
+		}
+	}
+
+	if input == sortedInput {
+		sort.Sort(int64s(out))
+	}
+
+	return out
+}
+
+func FuzzRoundTrip(f *testing.F) {
+	seeds(f)
+
+	f.Fuzz(func(t *testing.T, data []byte, shift uint8) {
+		for _, c := range codecs {
+			in := restrict(toInt32s(data, shift), c.input)
+			n := len(in)
+
+			codec := c.new()
+			compressed := make([]int32, 4*n+1024)
+			inpos, outpos := cursor.New(), cursor.New()
+			if err := codec.Compress(in, inpos, n, compressed, outpos); err != nil {
+				// Block based codecs also refuse arrays shorter than a block
+				if n == 0 || n < 128 && inpos.Get() == 0 {
+					continue
+				}
+				t.Fatalf("%s: compressing %d integers: %v", c.name, n, err)
+			}
+			if n == 0 {
+				t.Fatalf("%s: compressing nothing did not return an error", c.name)
+			}
+
+			consumed, length := inpos.Get(), outpos.Get()
+			out := make([]int32, n+1024)
+			inpos, outpos = cursor.New(), cursor.New()
+			if err := codec.Uncompress(compressed, inpos, length, out, outpos); err != nil {
+				t.Fatalf("%s: uncompressing %d integers: %v", c.name, consumed, err)
+			}
+
+			if outpos.Get() != consumed {
+				t.Fatalf("%s: uncompressed %d integers, expected %d", c.name, outpos.Get(), consumed)
+			}
+			for i := 0; i < consumed; i++ {
+				if out[i] != in[i] {
+					t.Fatalf("%s: integer %d of %d is %d, expected %d", c.name, i, n, out[i], in[i])
+				}
+			}
+		}
+
+		for _, c := range codecs64 {
+			in := restrict64(toInt32s(data, shift), c.input)
+			n := len(in)
+
+			codec := c.new()
+			compressed := make([]int32, 4*n+1024)
+			inpos, outpos := cursor.New(), cursor.New()
+			if err := codec.Compress(in, inpos, n, compressed, outpos); err != nil {
+				if n == 0 {
+					continue
+				}
+				t.Fatalf("%s: compressing %d integers: %v", c.name, n, err)
+			}
+			if n == 0 {
+				t.Fatalf("%s: compressing nothing did not return an error", c.name)
+			}
+
+			length := outpos.Get()
+			out := make([]int64, n)
+			inpos, outpos = cursor.New(), cursor.New()
+			if err := codec.Uncompress(compressed, inpos, length, out, outpos); err != nil {
+				t.Fatalf("%s: uncompressing %d integers: %v", c.name, n, err)
+			}
+
+			if outpos.Get() != n || inpos.Get() != length {
+				t.Fatalf("%s: uncompressed %d integers from %d int32s, expected %d from %d", c.name, outpos.Get(), inpos.Get(), n, length)
+			}
+			for i := range in {
+				if out[i] != in[i] {
+					t.Fatalf("%s: integer %d of %d is %d, expected %d", c.name, i, n, out[i], in[i])
+				}
+			}
+		}
+
+		ints := toInt32s(data, shift)
+		n := len(ints)
+
+		for _, c := range floats64 {
+			in := make([]float64, n)
+			for i, v := range ints {
+				in[i] = math.Float64frombits(uint64(uint32(v))<<32 | uint64(uint32(i)))
+			}
+
+			codec := c.new()
+			compressed := make([]int32, 4*n+1024)
+			inpos, outpos := cursor.New(), cursor.New()
+			if err := codec.Compress(in, inpos, n, compressed, outpos); err != nil {
+				if n == 0 {
+					continue
+				}
+				t.Fatalf("%s: compressing %d floats: %v", c.name, n, err)
+			}
+			if n == 0 {
+				t.Fatalf("%s: compressing nothing did not return an error", c.name)
+			}
+
+			length := outpos.Get()
+			out := make([]float64, n)
+			inpos, outpos = cursor.New(), cursor.New()
+			if err := codec.Uncompress(compressed, inpos, length, out, outpos); err != nil {
+				t.Fatalf("%s: uncompressing %d floats: %v", c.name, n, err)
+			}
+
+			if outpos.Get() != n {
+				t.Fatalf("%s: uncompressed %d floats, expected %d", c.name, outpos.Get(), n)
+			}
+			for i := range in {
+				if math.Float64bits(out[i]) != math.Float64bits(in[i]) {
+					t.Fatalf("%s: float %d of %d is %v, expected %v", c.name, i, n, out[i], in[i])
+				}
+			}
+		}
+
+		for _, c := range floats32 {
+			in := make([]float32, n)
+			for i, v := range ints {
+				in[i] = math.Float32frombits(uint32(v))
+			}
+
+			codec := c.new()
+			compressed := make([]int32, 4*n+1024)
+			inpos, outpos := cursor.New(), cursor.New()
+			if err := codec.Compress(in, inpos, n, compressed, outpos); err != nil {
+				if n == 0 {
+					continue
+				}
+				t.Fatalf("%s: compressing %d floats: %v", c.name, n, err)
+			}
+			if n == 0 {
+				t.Fatalf("%s: compressing nothing did not return an error", c.name)
+			}
+
+			length := outpos.Get()
+			out := make([]float32, n)
+			inpos, outpos = cursor.New(), cursor.New()
+			if err := codec.Uncompress(compressed, inpos, length, out, outpos); err != nil {
+				t.Fatalf("%s: uncompressing %d floats: %v", c.name, n, err)
+			}
+
+			if outpos.Get() != n {
+				t.Fatalf("%s: uncompressed %d floats, expected %d", c.name, outpos.Get(), n)
+			}
+			for i := range in {
+				if math.Float32bits(out[i]) != math.Float32bits(in[i]) {
+					t.Fatalf("%s: float %d of %d is %v, expected %v", c.name, i, n, out[i], in[i])
+				}
+			}
+		}
+	})
+}
+
+// FuzzDecodeGarbage only checks that the decoders return instead of panicking or
+// hanging; whether they report an error or decode some integers does not matter.
+func FuzzDecodeGarbage(f *testing.F) {
+	seeds(f)
+
+	f.Fuzz(func(t *testing.T, data []byte, shift uint8) {
+		in := toInt32s(data, shift)
+		if len(in) == 0 {
+			return
+		}
+
+		for _, c := range codecs {
+			out := make([]int32, 4096)
+			c.new().Uncompress(in, cursor.New(), len(in), out, cursor.New())
+		}
+
+		for _, c := range codecs64 {
+			out := make([]int64, 4096)
+			c.new().Uncompress(in, cursor.New(), len(in), out, cursor.New())
+		}
+
+		for _, c := range floats64 {
+			out := make([]float64, 4096)
+			c.new().Uncompress(in, cursor.New(), len(in), out, cursor.New())
+		}
+
+		for _, c := range floats32 {
+			out := make([]float32, 4096)
+			c.new().Uncompress(in, cursor.New(), len(in), out, cursor.New())
+		}
+	})
+}
+
+type int32s []int32
+
+func (this int32s) Len() int           { return len(this) }
+func (this int32s) Less(i, j int) bool { return this[i] < this[j] }
+func (this int32s) Swap(i, j int)      { this[i], this[j] = this[j], this[i] }
+
+type int64s []int64
+
+func (this int64s) Len() int           { return len(this) }
+func (this int64s) Less(i, j int) bool { return this[i] < this[j] }
+func (this int64s) Swap(i, j int)      { this[i], this[j] = this[j], this[i] }
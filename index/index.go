@@ -0,0 +1,205 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package index builds and reads small inverted indexes, stored as files of
+// little-endian int32s.
+// For each term, the identifiers of the documents containing it are compressed with
+// delta FastPFOR, the number of occurrences of the term in each of them with FastPFOR,
+// and the positions of the occurrences with delta variable byte, the positions of each
+// document following those of the previous one.
+// The file starts with a header, followed by the postings of every term, the term
+// dictionary, sorted by term, and the bytes of the terms:
+//
+//	header:     Magic, Version, number of documents, number of terms,
+//	            position of the dictionary, position of the terms, length of the terms in bytes
+//	postings:   for each term, its documents, frequencies and positions
+//	dictionary: for each term, the offset of its bytes, its number of documents, and the
+//	            positions of its documents, frequencies, positions and of their end
+//	terms:      the bytes of the terms, padded to a multiple of 4
+package index
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"sort"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/composition"
+	"github.com/dataence/encoding/cursor"
+	dfastpfor "github.com/dataence/encoding/delta/fastpfor"
+	dvb "github.com/dataence/encoding/delta/variablebyte"
+	"github.com/dataence/encoding/fastpfor"
+	"github.com/dataence/encoding/variablebyte"
+)
+
+const (
+	// "INDX" in little-endian order
+	Magic   = 0x58444e49
+	Version = 1
+
+	headerSize = 7
+	entrySize  = 6
+)
+
+// Codecs of the documents, frequencies and positions
+func newDocsCodec() encoding.Integer {
+	return composition.New(dfastpfor.New(), dvb.New())
+}
+
+func newFreqsCodec() encoding.Integer {
+	return composition.New(fastpfor.New(), variablebyte.New())
+}
+
+func newPositionsCodec() encoding.Integer {
+	return dvb.New()
+}
+
+// postings holds the documents containing a term, the number of occurrences of the term
+// in each of them, and their positions
+type postings struct {
+	docs      []int32
+	freqs     []int32
+	positions []int32
+}
+
+// Builder builds an index in memory, one document at a time, and writes it
+type Builder struct {
+	terms map[string]*postings
+	docs  int32
+}
+
+func NewBuilder() *Builder {
+	return &Builder{
+		terms: make(map[string]*postings),
+	}
+}
+
+// Add adds a document made of terms, the position of each term being its index, and
+// returns the identifier of the document, which is the number of documents added
+// before it
+func (this *Builder) Add(terms []string) int32 {
+	doc := this.docs
+	this.docs++
+
+	for i, term := range terms {
+		p, ok := this.terms[term]
+		if !ok {
+			p = &postings{}
+			this.terms[term] = p
+		}
+
+		if n := len(p.docs); n == 0 || p.docs[n-1] != doc {
+			p.docs = append(p.docs, doc)
+			p.freqs = append(p.freqs, 0)
+		}
+		p.freqs[len(p.freqs)-1]++
+		p.positions = append(p.positions, int32(i))
+	}
+
+	return doc
+}
+
+// WriteTo writes the index to w, and returns the number of bytes written
+func (this *Builder) WriteTo(w io.Writer) (int64, error) {
+	terms := make([]string, 0, len(this.terms))
+	for term := range this.terms {
+		terms = append(terms, term)
+	}
+	sort.Strings(terms)
+
+	words := make([]int32, headerSize)
+	dictionary := make([]int32, 0, entrySize*len(terms))
+	var blob []byte
+
+	docsCodec, freqsCodec, positionsCodec := newDocsCodec(), newFreqsCodec(), newPositionsCodec()
+
+	var err error
+	for _, term := range terms {
+		p := this.terms[term]
+		dictionary = append(dictionary, int32(len(blob)), int32(len(p.docs)), int32(len(words)))
+		blob = append(blob, term...)
+
+		if words, err = compress(docsCodec, p.docs, words); err != nil {
+			return 0, errors.New("index/WriteTo: " + err.Error())
+		}
+		dictionary = append(dictionary, int32(len(words)))
+
+		if words, err = compress(freqsCodec, p.freqs, words); err != nil {
+			return 0, errors.New("index/WriteTo: " + err.Error())
+		}
+		dictionary = append(dictionary, int32(len(words)))
+
+		if words, err = compress(positionsCodec, chain(p.positions, p.freqs), words); err != nil {
+			return 0, errors.New("index/WriteTo: " + err.Error())
+		}
+		dictionary = append(dictionary, int32(len(words)))
+	}
+
+	copy(words, []int32{Magic, Version, this.docs, int32(len(terms)), int32(len(words)), int32(len(words) + len(dictionary)), int32(len(blob))})
+	words = append(words, dictionary...)
+
+	buf := make([]byte, 4*len(words), 4*len(words)+len(blob)+3)
+	for i, v := range words {
+		binary.LittleEndian.PutUint32(buf[4*i:], uint32(v))
+	}
+	buf = append(buf, blob...)
+	for len(buf)%4 != 0 {
+		buf = append(buf, 0)
+	}
+
+	n, err := w.Write(buf)
+	return int64(n), err
+}
+
+// compress appends in, compressed with codec, to out
+func compress(codec encoding.Integer, in []int32, out []int32) ([]int32, error) {
+	buf := make([]int32, 2*len(in)+1024)
+
+	inpos, outpos := cursor.New(), cursor.New()
+	if err := codec.Compress(in, inpos, len(in), buf, outpos); err != nil {
+		return out, err
+	}
+	if inpos.Get() != len(in) {
+		return out, errors.New("codec compressed only part of the integers")
+	}
+
+	return append(out, buf[:outpos.Get()]...), nil
+}
+
+// chain returns the positions of all the documents as a single increasing sequence,
+// each position being offset by one more than the last one of the previous document,
+// so that their differences stay small
+func chain(positions []int32, freqs []int32) []int32 {
+	chained := make([]int32, len(positions))
+
+	i, base := 0, int32(0)
+	for _, f := range freqs {
+		for _, p := range positions[i : i+int(f)] {
+			chained[i] = base + p
+			i++
+		}
+		base = chained[i-1] + 1
+	}
+
+	return chained
+}
+
+// unchain reverses chain
+func unchain(chained []int32, freqs []int32) []int32 {
+	positions := make([]int32, len(chained))
+
+	i, base := 0, int32(0)
+	for _, f := range freqs {
+		for _, p := range chained[i : i+int(f)] {
+			positions[i] = p - base
+			i++
+		}
+		base = chained[i-1] + 1
+	}
+
+	return positions
+}
@@ -0,0 +1,195 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package index
+
+import (
+	"bytes"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// corpus returns documents whose terms follow a skewed distribution, so that some
+// terms are in most documents and others in a few
+func corpus(n int) [][]string {
+	r := rand.New(rand.NewSource(1))
+	z := rand.NewZipf(r, 1.1, 1, 5000)
+
+	docs := make([][]string, n)
+	for i := range docs {
+		docs[i] = make([]string, 5+r.Intn(100))
+		for j := range docs[i] {
+			docs[i][j] = "t" + strconv.Itoa(int(z.Uint64()))
+		}
+	}
+
+	return docs
+}
+
+func build(t *testing.T, docs [][]string) []byte {
+	b := NewBuilder()
+	for i, doc := range docs {
+		if id := b.Add(doc); id != int32(i) {
+			t.Fatalf("index/build: document %d has identifier %d", i, id)
+		}
+	}
+
+	var buf bytes.Buffer
+	n, err := b.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if int(n) != buf.Len() {
+		t.Fatalf("index/build: wrote %d bytes, reported %d", buf.Len(), n)
+	}
+
+	return buf.Bytes()
+}
+
+func TestLookup(t *testing.T) {
+	docs := corpus(2000)
+
+	path := filepath.Join(t.TempDir(), "index")
+	if err := os.WriteFile(path, build(t, docs), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.NumDocs() != len(docs) {
+		t.Fatalf("index/TestLookup: %d documents, expected %d", r.NumDocs(), len(docs))
+	}
+
+	// Expected positions of each term in each document
+	expected := make(map[string]map[int32][]int32)
+	for i, doc := range docs {
+		for j, term := range doc {
+			if expected[term] == nil {
+				expected[term] = make(map[int32][]int32)
+			}
+			expected[term][int32(i)] = append(expected[term][int32(i)], int32(j))
+		}
+	}
+	if r.NumTerms() != len(expected) {
+		t.Fatalf("index/TestLookup: %d terms, expected %d", r.NumTerms(), len(expected))
+	}
+
+	for term, postings := range expected {
+		if r.DocFreq(term) != len(postings) {
+			t.Fatalf("index/TestLookup: %s in %d documents, expected %d", term, r.DocFreq(term), len(postings))
+		}
+
+		p, err := r.Lookup(term)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Len() != len(postings) {
+			t.Fatalf("index/TestLookup: %d postings for %s, expected %d", p.Len(), term, len(postings))
+		}
+
+		for i, doc := range p.Docs {
+			positions := postings[doc]
+			if int(p.Freqs[i]) != len(positions) || len(p.Positions(i)) != len(positions) {
+				t.Fatalf("index/TestLookup: %s in document %d %d times, expected %d", term, doc, p.Freqs[i], len(positions))
+			}
+			for k, pos := range p.Positions(i) {
+				if pos != positions[k] {
+					t.Fatalf("index/TestLookup: %s at %d in document %d, expected %d", term, pos, doc, positions[k])
+				}
+			}
+		}
+	}
+
+	if p, err := r.Lookup("missing"); p != nil || err != nil || r.DocFreq("missing") != 0 {
+		t.Fatalf("index/TestLookup: postings found for a missing term")
+	}
+}
+
+func TestAnd(t *testing.T) {
+	docs := corpus(2000)
+	r, err := NewReader(build(t, docs))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, query := range [][]string{{"t1"}, {"t1", "t2"}, {"t3", "t1", "t40"}, {"t1", "t2", "t3", "t4", "t5"}, {"t1", "missing"}, {"t100", "t101"}} {
+		var expected []int32
+		for i, doc := range docs {
+			all := true
+			for _, term := range query {
+				found := false
+				for _, d := range doc {
+					if d == term {
+						found = true
+						break
+					}
+				}
+				all = all && found
+			}
+			if all {
+				expected = append(expected, int32(i))
+			}
+		}
+
+		result, err := r.And(query...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result) != len(expected) {
+			t.Fatalf("index/TestAnd: %d documents for %v, expected %d", len(result), query, len(expected))
+		}
+		for i := range result {
+			if result[i] != expected[i] {
+				t.Fatalf("index/TestAnd: document %d for %v is %d, expected %d", i, query, result[i], expected[i])
+			}
+		}
+	}
+}
+
+func TestCorrupted(t *testing.T) {
+	data := build(t, corpus(200))
+
+	if _, err := NewReader(data[:len(data)-2]); err == nil {
+		t.Fatalf("index/TestCorrupted: no error for a truncated index")
+	}
+
+	// Flipping bytes must not make lookups panic
+	r := rand.New(rand.NewSource(1))
+	for k := 0; k < 200; k++ {
+		corrupted := append([]byte{}, data...)
+		for j := 0; j < 4; j++ {
+			corrupted[r.Intn(len(corrupted))] ^= byte(1 + r.Intn(255))
+		}
+
+		reader, err := NewReader(corrupted)
+		if err != nil {
+			continue
+		}
+		for term := 0; term < reader.NumTerms(); term++ {
+			reader.Lookup(reader.Term(term))
+		}
+	}
+}
+
+func BenchmarkAnd(b *testing.B) {
+	b.StopTimer()
+	builder := NewBuilder()
+	for _, doc := range corpus(20000) {
+		builder.Add(doc)
+	}
+	var buf bytes.Buffer
+	builder.WriteTo(&buf)
+	r, _ := NewReader(buf.Bytes())
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		r.And("t1", "t2", "t10")
+	}
+}
@@ -0,0 +1,298 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package index
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/cursor"
+)
+
+// Reader reads an index: this is not thread-safe, as the codecs have working areas
+// (need one per thread)
+type Reader struct {
+	words      []int32
+	docs       int
+	dictionary []int32
+	blob       string
+
+	docsCodec, freqsCodec, positionsCodec encoding.Integer
+}
+
+// Open reads the index stored in the file at path
+func Open(path string) (*Reader, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewReader(data)
+}
+
+// NewReader returns a reader of the index held by data
+func NewReader(data []byte) (*Reader, error) {
+	if len(data)%4 != 0 || len(data) < 4*headerSize {
+		return nil, errors.New("index/NewReader: invalid size " + strconv.Itoa(len(data)))
+	}
+
+	words := make([]int32, len(data)/4)
+	for i := range words {
+		words[i] = int32(binary.LittleEndian.Uint32(data[4*i:]))
+	}
+
+	if words[0] != Magic {
+		return nil, errors.New("index/NewReader: not an index")
+	}
+	if words[1] != Version {
+		return nil, errors.New("index/NewReader: unsupported version " + strconv.Itoa(int(words[1])))
+	}
+
+	docs, terms := int(words[2]), int(words[3])
+	dictpos, blobpos, bloblen := int(words[4]), int(words[5]), int(words[6])
+	if docs < 0 || terms < 0 || dictpos < headerSize || blobpos != dictpos+entrySize*terms || bloblen < 0 || bloblen > 4*(len(words)-blobpos) {
+		return nil, errors.New("index/NewReader: invalid header. Data may be corrupted.")
+	}
+
+	this := &Reader{
+		words:          words,
+		docs:           docs,
+		dictionary:     words[dictpos:blobpos],
+		blob:           string(data[4*blobpos : 4*blobpos+bloblen]),
+		docsCodec:      newDocsCodec(),
+		freqsCodec:     newFreqsCodec(),
+		positionsCodec: newPositionsCodec(),
+	}
+
+	// The positions and offsets must increase, so that lookups stay within the data
+	pos, offset := headerSize, 0
+	for t := 0; t < terms; t++ {
+		e := this.dictionary[entrySize*t : entrySize*(t+1)]
+		if int(e[0]) < offset || int(e[2]) != pos || e[3] < e[2] || e[4] < e[3] || e[5] < e[4] || int(e[5]) > dictpos {
+			return nil, errors.New("index/NewReader: invalid dictionary entry " + strconv.Itoa(t) + ". Data may be corrupted.")
+		}
+
+		// Documents and frequencies take at least one bit each, once compressed
+		if e[1] < 0 || int(e[1]) > docs || int(e[1]) > 32*int(e[3]-e[2]) || int(e[1]) > 32*int(e[4]-e[3]) {
+			return nil, errors.New("index/NewReader: invalid dictionary entry " + strconv.Itoa(t) + ". Data may be corrupted.")
+		}
+		pos, offset = int(e[5]), int(e[0])
+	}
+	if offset > bloblen {
+		return nil, errors.New("index/NewReader: invalid dictionary. Data may be corrupted.")
+	}
+
+	return this, nil
+}
+
+// NumDocs returns the number of documents
+func (this *Reader) NumDocs() int {
+	return this.docs
+}
+
+// NumTerms returns the number of terms
+func (this *Reader) NumTerms() int {
+	return len(this.dictionary) / entrySize
+}
+
+// Term returns the t-th term, in sorted order
+func (this *Reader) Term(t int) string {
+	start := int(this.dictionary[entrySize*t])
+	end := len(this.blob)
+	if t+1 < this.NumTerms() {
+		end = int(this.dictionary[entrySize*(t+1)])
+	}
+
+	return this.blob[start:end]
+}
+
+// find returns the index of term in the dictionary, or -1
+func (this *Reader) find(term string) int {
+	t := sort.Search(this.NumTerms(), func(t int) bool {
+		return this.Term(t) >= term
+	})
+
+	if t < this.NumTerms() && this.Term(t) == term {
+		return t
+	}
+
+	return -1
+}
+
+// DocFreq returns the number of documents containing term, without decoding its postings
+func (this *Reader) DocFreq(term string) int {
+	t := this.find(term)
+	if t < 0 {
+		return 0
+	}
+
+	return int(this.dictionary[entrySize*t+1])
+}
+
+// Postings holds the documents containing a term, in increasing order, with the
+// number of occurrences of the term in each of them and their positions
+type Postings struct {
+	Docs  []int32
+	Freqs []int32
+
+	positions []int32
+	offsets   []int
+}
+
+// Len returns the number of documents
+func (this *Postings) Len() int {
+	return len(this.Docs)
+}
+
+// Positions returns the positions of the term in its i-th document
+func (this *Postings) Positions(i int) []int32 {
+	return this.positions[this.offsets[i]:this.offsets[i+1]]
+}
+
+// Lookup returns the postings of term, or nil if no document contains it
+func (this *Reader) Lookup(term string) (*Postings, error) {
+	t := this.find(term)
+	if t < 0 {
+		return nil, nil
+	}
+
+	e := this.dictionary[entrySize*t : entrySize*(t+1)]
+	df := int(e[1])
+
+	docs, err := this.decode(this.docsCodec, int(e[2]), int(e[3]), df)
+	if err != nil {
+		return nil, errors.New("index/Lookup: documents of " + term + ": " + err.Error())
+	}
+
+	freqs, err := this.decode(this.freqsCodec, int(e[3]), int(e[4]), df)
+	if err != nil {
+		return nil, errors.New("index/Lookup: frequencies of " + term + ": " + err.Error())
+	}
+
+	offsets := make([]int, df+1)
+	for i, f := range freqs {
+		if f < 1 || (i > 0 && docs[i] <= docs[i-1]) {
+			return nil, errors.New("index/Lookup: invalid postings of " + term + ". Data may be corrupted.")
+		}
+		offsets[i+1] = offsets[i] + int(f)
+	}
+
+	// Positions take at least one byte each, once compressed
+	if offsets[df] > 4*int(e[5]-e[4]) {
+		return nil, errors.New("index/Lookup: invalid positions of " + term + ". Data may be corrupted.")
+	}
+
+	chained, err := this.decode(this.positionsCodec, int(e[4]), int(e[5]), offsets[df])
+	if err != nil {
+		return nil, errors.New("index/Lookup: positions of " + term + ": " + err.Error())
+	}
+
+	return &Postings{
+		Docs:      docs,
+		Freqs:     freqs,
+		positions: unchain(chained, freqs),
+		offsets:   offsets,
+	}, nil
+}
+
+// decode returns the n integers compressed with codec between the positions from and to
+func (this *Reader) decode(codec encoding.Integer, from, to, n int) ([]int32, error) {
+	out := make([]int32, n)
+	if n == 0 {
+		return out, nil
+	}
+	if from == to {
+		return nil, errors.New("no compressed data")
+	}
+
+	inpos, outpos := cursor.New(), cursor.New()
+	inpos.Set(from)
+	if err := codec.Uncompress(this.words[:to], inpos, to-from, out, outpos); err != nil {
+		return nil, err
+	}
+	if inpos.Get() != to || outpos.Get() != n {
+		return nil, errors.New("wrong number of integers. Data may be corrupted.")
+	}
+
+	return out, nil
+}
+
+// And returns the documents containing all the terms, in increasing order. The lists of
+// documents are intersected from the shortest one, so that the result stays small.
+func (this *Reader) And(terms ...string) ([]int32, error) {
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	sorted := make([]string, len(terms))
+	copy(sorted, terms)
+	sort.Slice(sorted, func(i, j int) bool {
+		return this.DocFreq(sorted[i]) < this.DocFreq(sorted[j])
+	})
+
+	var result []int32
+	for i, term := range sorted {
+		// Only the documents are decoded
+		t := this.find(term)
+		if t < 0 {
+			return nil, nil
+		}
+
+		e := this.dictionary[entrySize*t : entrySize*(t+1)]
+		docs, err := this.decode(this.docsCodec, int(e[2]), int(e[3]), int(e[1]))
+		if err != nil {
+			return nil, errors.New("index/And: documents of " + term + ": " + err.Error())
+		}
+
+		if i == 0 {
+			result = docs
+		} else {
+			result = intersect(result, docs)
+		}
+
+		if len(result) == 0 {
+			return nil, nil
+		}
+	}
+
+	return result, nil
+}
+
+// intersect returns the integers of a, the shorter one, that are in b, both being sorted.
+// Each integer of a is searched in the rest of b by galloping.
+func intersect(a, b []int32) []int32 {
+	out := a[:0:0]
+
+	for _, v := range a {
+		// Find a range of b holding v by doubling the step, then search it
+		step := 1
+		for step < len(b) && b[step-1] < v {
+			step *= 2
+		}
+		if step > len(b) {
+			step = len(b)
+		}
+
+		j := sort.Search(step, func(i int) bool {
+			return b[i] >= v
+		})
+		if j < len(b) && b[j] == v {
+			out = append(out, v)
+		}
+		b = b[j:]
+
+		if len(b) == 0 {
+			break
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package intarray implements an array of integers held compressed in memory, which
+// grows by appending and supports random access.
+// The integers are stored in 128-integer blocks encoded as done by package frameofref,
+// i.e. bit packed as done by BP32 once the minimum of the block is subtracted, so that
+// any integer can be read without decoding the rest of its block. The last integers,
+// fewer than 128, are kept uncompressed until their block is full.
+package intarray
+
+import (
+	"strconv"
+
+	"github.com/dataence/encoding/frameofref"
+)
+
+const (
+	DefaultBlockSize = frameofref.DefaultBlockSize
+
+	// Largest number of int32s of a compressed block: its header and 128 integers
+	maxBlockSize = 2 + DefaultBlockSize
+)
+
+// IntArray is a compressed array of integers. Reading it concurrently is safe, but
+// appending to it is not.
+type IntArray struct {
+	// Compressed blocks, and the position of each of them in data
+	data   []int32
+	blocks []int
+
+	// Integers following the blocks, not compressed yet
+	tail  [DefaultBlockSize]int32
+	ntail int
+}
+
+func New() *IntArray {
+	return &IntArray{}
+}
+
+// Len returns the number of integers in the array
+func (this *IntArray) Len() int {
+	return len(this.blocks)*DefaultBlockSize + this.ntail
+}
+
+// CompressedSize returns the number of int32s used by the compressed blocks and the
+// tail, not counting the positions of the blocks
+func (this *IntArray) CompressedSize() int {
+	return len(this.data) + this.ntail
+}
+
+// Append adds values at the end of the array
+func (this *IntArray) Append(values ...int32) {
+	for len(values) > 0 {
+		n := copy(this.tail[this.ntail:], values)
+		this.ntail += n
+		values = values[n:]
+
+		if this.ntail == DefaultBlockSize {
+			this.flush()
+		}
+	}
+}
+
+// flush compresses the tail, which is full, as a new block
+func (this *IntArray) flush() {
+	pos := len(this.data)
+	if cap(this.data)-pos < maxBlockSize {
+		data := make([]int32, pos, 2*cap(this.data)+maxBlockSize)
+		copy(data, this.data)
+		this.data = data
+	}
+
+	this.data = this.data[:pos+maxBlockSize]
+	this.data = this.data[:frameofref.EncodeBlock(this.tail[:], 0, this.data, pos)]
+	this.blocks = append(this.blocks, pos)
+	this.ntail = 0
+}
+
+// Get returns the integer at index i, and panics if i is out of range
+func (this *IntArray) Get(i int) int32 {
+	if i < 0 || i >= this.Len() {
+		panic("intarray/Get: index " + strconv.Itoa(i) + " out of range [0:" + strconv.Itoa(this.Len()) + "]")
+	}
+
+	b := i / DefaultBlockSize
+	if b == len(this.blocks) {
+		return this.tail[i%DefaultBlockSize]
+	}
+
+	v, err := frameofref.Get(this.data, this.blocks[b], i%DefaultBlockSize)
+	if err != nil {
+		panic("intarray/Get: " + err.Error())
+	}
+
+	return v
+}
+
+// Range calls f with the index and value of each integer, in order, until f returns
+// false. It decodes each block once, so it is faster than calling Get for every index.
+func (this *IntArray) Range(f func(i int, v int32) bool) {
+	var block [DefaultBlockSize]int32
+
+	for b, pos := range this.blocks {
+		if _, err := frameofref.DecodeBlock(this.data, pos, block[:], 0); err != nil {
+			panic("intarray/Range: " + err.Error())
+		}
+		for j, v := range block {
+			if !f(b*DefaultBlockSize+j, v) {
+				return
+			}
+		}
+	}
+
+	s := len(this.blocks) * DefaultBlockSize
+	for j, v := range this.tail[:this.ntail] {
+		if !f(s+j, v) {
+			return
+		}
+	}
+}
+
+// Slice returns a new slice holding the integers from index from up to, but not
+// including, index to, and panics if they are out of range
+func (this *IntArray) Slice(from, to int) []int32 {
+	if from < 0 || to < from || to > this.Len() {
+		panic("intarray/Slice: slice bounds [" + strconv.Itoa(from) + ":" + strconv.Itoa(to) + "] out of range [0:" + strconv.Itoa(this.Len()) + "]")
+	}
+
+	out := make([]int32, to-from)
+
+	var block [DefaultBlockSize]int32
+	for i := from; i < to; {
+		b, j := i/DefaultBlockSize, i%DefaultBlockSize
+
+		if b == len(this.blocks) {
+			copy(out[i-from:], this.tail[j:to-b*DefaultBlockSize])
+			break
+		}
+
+		// Whole blocks are decoded in place
+		if j == 0 && to-i >= DefaultBlockSize {
+			if _, err := frameofref.DecodeBlock(this.data, this.blocks[b], out, i-from); err != nil {
+				panic("intarray/Slice: " + err.Error())
+			}
+			i += DefaultBlockSize
+			continue
+		}
+
+		if _, err := frameofref.DecodeBlock(this.data, this.blocks[b], block[:], 0); err != nil {
+			panic("intarray/Slice: " + err.Error())
+		}
+		i += copy(out[i-from:], block[j:])
+	}
+
+	return out
+}
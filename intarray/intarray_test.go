@@ -0,0 +1,155 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package intarray
+
+import (
+	"log"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/dataence/encoding/generators"
+)
+
+var (
+	data []int32
+	size int = 128000
+)
+
+func init() {
+	log.Printf("intarray/init: generating %d int32s\n", size)
+	data = generators.GenerateClustered(size, size*2)
+	data[10] = math.MinInt32
+	data[300] = math.MaxInt32
+	data[size-1] = -1
+	log.Printf("intarray/init: generated %d integers for test", size)
+}
+
+// build appends data to a new array in chunks of random sizes
+func build(data []int32) *IntArray {
+	r := rand.New(rand.NewSource(1))
+	a := New()
+	for s := 0; s < len(data); {
+		n := r.Intn(300)
+		if n > len(data)-s {
+			n = len(data) - s
+		}
+		a.Append(data[s : s+n]...)
+		s += n
+	}
+
+	return a
+}
+
+func TestGet(t *testing.T) {
+	for _, n := range []int{0, 1, 127, 128, 129, 1000, size} {
+		a := build(data[:n])
+		if a.Len() != n {
+			t.Fatalf("intarray/TestGet: length %d, expected %d", a.Len(), n)
+		}
+
+		for i := 0; i < n; i++ {
+			if v := a.Get(i); v != data[i] {
+				t.Fatalf("intarray/TestGet: integer %d of %d is %d, expected %d", i, n, v, data[i])
+			}
+		}
+	}
+}
+
+func TestGetOutOfRange(t *testing.T) {
+	a := build(data[:200])
+	for _, i := range []int{-1, 200, 1000} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("intarray/TestGetOutOfRange: no panic for index %d", i)
+				}
+			}()
+			a.Get(i)
+		}()
+	}
+}
+
+func TestRange(t *testing.T) {
+	a := build(data)
+
+	next := 0
+	a.Range(func(i int, v int32) bool {
+		if i != next || v != data[i] {
+			t.Fatalf("intarray/TestRange: integer %d is %d, expected integer %d, %d", i, v, next, data[next])
+		}
+		next++
+		return true
+	})
+	if next != len(data) {
+		t.Fatalf("intarray/TestRange: %d integers, expected %d", next, len(data))
+	}
+
+	// Stopping early
+	next = 0
+	a.Range(func(i int, v int32) bool {
+		next++
+		return i < 200
+	})
+	if next != 201 {
+		t.Fatalf("intarray/TestRange: %d integers after stopping at 200", next)
+	}
+}
+
+func TestSlice(t *testing.T) {
+	a := build(data[:1000])
+
+	for _, r := range [][2]int{{0, 0}, {0, 1000}, {5, 10}, {100, 300}, {128, 256}, {127, 897}, {896, 1000}, {950, 990}, {1000, 1000}} {
+		s := a.Slice(r[0], r[1])
+		if len(s) != r[1]-r[0] {
+			t.Fatalf("intarray/TestSlice: [%d:%d] has length %d", r[0], r[1], len(s))
+		}
+		for i, v := range s {
+			if v != data[r[0]+i] {
+				t.Fatalf("intarray/TestSlice: integer %d of [%d:%d] is %d, expected %d", i, r[0], r[1], v, data[r[0]+i])
+			}
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("intarray/TestSlice: no panic for [900:1001]")
+		}
+	}()
+	a.Slice(900, 1001)
+}
+
+func TestCompressedSize(t *testing.T) {
+	a := build(data)
+	if a.CompressedSize()*2 > len(data) {
+		t.Fatalf("intarray/TestCompressedSize: %d int32s for %d integers", a.CompressedSize(), len(data))
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	b.StopTimer()
+	length := 128 * 1024
+	a := build(generators.GenerateClustered(length, 1<<24))
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		a.Get(j * 7919 % length)
+	}
+}
+
+func BenchmarkRange(b *testing.B) {
+	b.StopTimer()
+	length := 128 * 1024
+	a := build(generators.GenerateClustered(length, 1<<24))
+	sum := int32(0)
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		a.Range(func(i int, v int32) bool {
+			sum += v
+			return true
+		})
+	}
+}
@@ -36,3 +36,17 @@ type Integer interface {
 	 */
 	Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error
 }
+
+// DeltaInteger is implemented by the codecs that compress the differences between
+// successive integers. The first difference is computed from initoffset instead of 0,
+// so that consecutive chunks of a sorted array can be compressed one after the other,
+// each chunk starting from the last integer of the previous one.
+type DeltaInteger interface {
+	Integer
+
+	// CompressFrom is Compress with the first difference computed from initoffset
+	CompressFrom(initoffset int32, in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error
+
+	// UncompressFrom is Uncompress with the first integer added to initoffset
+	UncompressFrom(initoffset int32, in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error
+}
@@ -0,0 +1,23 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package encoding
+
+import (
+	"github.com/dataence/encoding/cursor"
+)
+
+// Integer64 is the int64 counterpart of Integer. The compressed data is stored in
+// an array of int32s, as it is for int32s.
+type Integer64 interface {
+	// Compress inlength int64s from in, starting at inpos, to out, starting at outpos.
+	// Both inpos and outpos are modified to represent how much data was read and written.
+	Compress(in []int64, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error
+
+	// Uncompress data from in, starting at inpos, to out, starting at outpos.
+	// Both inpos and outpos are modified to represent how much data was read and written.
+	Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int64, outpos *cursor.Cursor) error
+}
@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package interpolative is an implementation of the binary interpolative coding
+// integer compression algorithm in Go.
+// It is only suitable for strictly increasing arrays of integers, such as
+// posting lists. It usually achieves better compression than the other codecs,
+// at the price of a much slower decoding.
+// For details, please see
+// Alistair Moffat and Lang Stuiver, Binary Interpolative Coding for Effective
+// Index Compression, Information Retrieval 3(1), 2000
+// http://dx.doi.org/10.1023/A:1013002601898
+package interpolative
+
+import (
+	"errors"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/bitio"
+	"github.com/dataence/encoding/cursor"
+)
+
+type Interpolative struct {
+}
+
+var _ encoding.Integer = (*Interpolative)(nil)
+
+func New() encoding.Integer {
+	return &Interpolative{}
+}
+
+func (this *Interpolative) Compress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("interpolative/Compress: inlength = 0. No work done.")
+	}
+
+	s := inpos.Get()
+	data := in[s : s+inlength]
+
+	for i := 1; i < len(data); i++ {
+		if data[i] <= data[i-1] {
+			return errors.New("interpolative/Compress: input is not strictly increasing")
+		}
+	}
+
+	tmpoutpos := outpos.Get()
+	out[tmpoutpos] = int32(inlength)
+	out[tmpoutpos+1] = data[0]
+	out[tmpoutpos+2] = data[inlength-1]
+
+	w := bitio.NewWriter(out, tmpoutpos+3)
+	if inlength > 2 {
+		encodeInterpolative(w, data[1:inlength-1], int64(data[0])+1, int64(data[inlength-1])-1)
+	}
+	w.Flush()
+
+	inpos.Add(inlength)
+	outpos.Set(w.Pos())
+
+	return nil
+}
+
+func (this *Interpolative) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) (err error) {
+	if inlength == 0 {
+		return errors.New("interpolative/Uncompress: inlength = 0. No work done.")
+	}
+
+	defer encoding.Recover("interpolative/Uncompress", &err)
+
+	tmpinpos := inpos.Get()
+	outlength := int(in[tmpinpos])
+	first := in[tmpinpos+1]
+	last := in[tmpinpos+2]
+
+	tmpoutpos := outpos.Get()
+	out[tmpoutpos] = first
+	out[tmpoutpos+outlength-1] = last
+
+	r := bitio.NewReader(in, tmpinpos+3)
+	if outlength > 2 {
+		decodeInterpolative(r, out[tmpoutpos+1:tmpoutpos+outlength-1], int64(first)+1, int64(last)-1)
+	}
+
+	inpos.Set(r.Pos())
+	outpos.Add(outlength)
+
+	return nil
+}
+
+// encodeInterpolative encodes the middle element of data, whose values are known to be
+// within [lo, hi], then recurses on both halves with the tightened ranges.
+func encodeInterpolative(w *bitio.Writer, data []int32, lo, hi int64) {
+	m := len(data)
+	if m == 0 {
+		return
+	}
+
+	mid := m / 2
+	v := int64(data[mid])
+
+	// There are mid values before v and m-1-mid values after it
+	minv := lo + int64(mid)
+	maxv := hi - int64(m-1-mid)
+	writeMinimalBinary(w, uint64(v-minv), uint64(maxv-minv+1))
+
+	encodeInterpolative(w, data[:mid], lo, v-1)
+	encodeInterpolative(w, data[mid+1:], v+1, hi)
+}
+
+func decodeInterpolative(r *bitio.Reader, out []int32, lo, hi int64) {
+	m := len(out)
+	if m == 0 {
+		return
+	}
+
+	mid := m / 2
+	minv := lo + int64(mid)
+	maxv := hi - int64(m-1-mid)
+	v := minv + int64(readMinimalBinary(r, uint64(maxv-minv+1)))
+	out[mid] = int32(v)
+
+	decodeInterpolative(r, out[:mid], lo, v-1)
+	decodeInterpolative(r, out[mid+1:], v+1, hi)
+}
+
+// writeMinimalBinary writes x, which is in [0, n), using the truncated binary code:
+// the first 2^(k+1)-n values use k bits, the others k+1 bits, where k = floor(log2(n)).
+func writeMinimalBinary(w *bitio.Writer, x, n uint64) {
+	if n <= 1 {
+		return
+	}
+
+	k := uint(encoding.LeadingBitPosition(uint32(n >> 1)))
+	u := uint64(1)<<(k+1) - n
+
+	if x < u {
+		w.Write(uint32(x), k)
+	} else {
+		y := x + u
+		w.Write(uint32(y>>1), k)
+		w.Write(uint32(y&1), 1)
+	}
+}
+
+func readMinimalBinary(r *bitio.Reader, n uint64) uint64 {
+	if n <= 1 {
+		return 0
+	}
+
+	k := uint(encoding.LeadingBitPosition(uint32(n >> 1)))
+	u := uint64(1)<<(k+1) - n
+
+	x := uint64(r.Read(k))
+	if x < u {
+		return x
+	}
+
+	return (x<<1 | uint64(r.Read(1))) - u
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package interpolative
+
+import (
+	"log"
+	"math"
+	"testing"
+
+	"github.com/dataence/encoding/benchtools"
+	"github.com/dataence/encoding/cursor"
+	"github.com/dataence/encoding/generators"
+)
+
+var (
+	data []int32
+	size int = 128000
+)
+
+func init() {
+	log.Printf("interpolative/init: generating %d int32s\n", size)
+	data = generators.GenerateClustered(size, size*2)
+	log.Printf("interpolative/init: generated %d integers for test", size)
+}
+
+func TestCodec(t *testing.T) {
+	sizes := []int{128, 128 * 10, 128 * 100, 128 * 1000}
+	benchtools.TestCodec(New(), data, sizes)
+}
+
+func TestSmall(t *testing.T) {
+	for _, k := range []int{1, 2, 3, 65, 100} {
+		out := make([]int32, 100)
+		outpos := cursor.New()
+		if err := New().Compress(data, cursor.New(), k, out, outpos); err != nil {
+			t.Fatal(err)
+		}
+
+		recov := make([]int32, k)
+		if err := New().Uncompress(out, cursor.New(), outpos.Get(), recov, cursor.New()); err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i < k; i++ {
+			if recov[i] != data[i] {
+				t.Fatalf("interpolative/TestSmall: Problem recovering. index = %d, in = %d, recovered = %d, original length = %d", i, data[i], recov[i], k)
+			}
+		}
+	}
+}
+
+func TestExtremes(t *testing.T) {
+	in := []int32{math.MinInt32, math.MinInt32 + 1, -5, 0, 7, 1 << 30, math.MaxInt32 - 1, math.MaxInt32}
+	out := make([]int32, 100)
+	outpos := cursor.New()
+	if err := New().Compress(in, cursor.New(), len(in), out, outpos); err != nil {
+		t.Fatal(err)
+	}
+
+	recov := make([]int32, len(in))
+	if err := New().Uncompress(out, cursor.New(), outpos.Get(), recov, cursor.New()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range in {
+		if recov[i] != in[i] {
+			t.Fatalf("interpolative/TestExtremes: Problem recovering. index = %d, in = %d, recovered = %d", i, in[i], recov[i])
+		}
+	}
+}
+
+func TestNotStrictlyIncreasing(t *testing.T) {
+	in := []int32{1, 5, 5}
+	out := make([]int32, 100)
+	if err := New().Compress(in, cursor.New(), len(in), out, cursor.New()); err == nil {
+		t.Fatal("interpolative/TestNotStrictlyIncreasing: expected error for repeated values")
+	}
+}
+
+// go test -bench=Decode
+func BenchmarkDecode(b *testing.B) {
+	b.StopTimer()
+	length := 128 * 1024
+	data := generators.GenerateClustered(length, 1<<24)
+	compdata := make([]int32, 2*length)
+	recov := make([]int32, length)
+	inpos := cursor.New()
+	outpos := cursor.New()
+	codec := New()
+	codec.Compress(data, inpos, len(data), compdata, outpos)
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		newinpos := cursor.New()
+		newoutpos := cursor.New()
+		codec.Uncompress(compdata, newinpos, outpos.Get()-newinpos.Get(), recov, newoutpos)
+	}
+}
@@ -0,0 +1,587 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package pef is an implementation of the partitioned Elias-Fano integer
+// compression algorithm in Go.
+// It is only suitable for sorted (non-decreasing) arrays of integers, such as
+// posting lists. The array is split into partitions, each of which is stored
+// as a run, a dense bitmap or a regular Elias-Fano sequence, whichever is the
+// smallest. Clustered data, where plain Elias-Fano wastes space, benefits the most.
+// For details, please see
+// Giuseppe Ottaviano and Rossano Venturini, Partitioned Elias-Fano Indexes,
+// SIGIR 2014 http://dx.doi.org/10.1145/2600428.2609615
+package pef
+
+import (
+	"errors"
+	"math/bits"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/cursor"
+)
+
+const (
+	// Partition boundaries are only considered at multiples of this value
+	DefaultGranularity = 64
+
+	// Maximum number of integers in a single partition
+	DefaultMaxPartitionSize = 4096
+
+	// Every SkipQuantum-th element of an Elias-Fano partition gets a skip pointer
+	SkipQuantum = 64
+
+	// Number of int32s used by each entry of the partition directory
+	DirectoryEntrySize = 4
+
+	// Number of int32s used by the header: length, number of partitions, first value
+	HeaderSize = 3
+)
+
+// Partition types
+const (
+	TypeRun = iota
+	TypeBitmap
+	TypeEliasFano
+)
+
+// PEF codec structure: this is not thread-safe (need one per thread)
+type PEF struct {
+	// Working area
+	bounds []int
+}
+
+var _ encoding.Integer = (*PEF)(nil)
+
+func New() encoding.Integer {
+	return &PEF{}
+}
+
+func (this *PEF) Compress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("pef/Compress: inlength = 0. No work done.")
+	}
+
+	s := inpos.Get()
+	data := in[s : s+inlength]
+
+	for i := 1; i < len(data); i++ {
+		if data[i] < data[i-1] {
+			return errors.New("pef/Compress: input is not sorted")
+		}
+	}
+
+	this.bounds = partition(data, this.bounds[:0])
+	numparts := len(this.bounds) - 1
+
+	headerpos := outpos.Get()
+	out[headerpos] = int32(inlength)
+	out[headerpos+1] = int32(numparts)
+	out[headerpos+2] = data[0]
+
+	dirpos := headerpos + HeaderSize
+	datapos := dirpos + numparts*DirectoryEntrySize
+	tmpoutpos := datapos
+
+	for p := 0; p < numparts; p++ {
+		from, to := this.bounds[p], this.bounds[p+1]
+		base := partitionBase(data, from)
+		ptype, l, _ := bestType(data, from, to, base)
+
+		d := dirpos + p*DirectoryEntrySize
+		out[d] = data[to-1]
+		out[d+1] = int32(to)
+		out[d+2] = int32(tmpoutpos - datapos)
+		out[d+3] = int32(ptype) | int32(l)<<8
+
+		switch ptype {
+		case TypeBitmap:
+			tmpoutpos += encodeBitmap(data[from:to], base, out, tmpoutpos)
+		case TypeEliasFano:
+			tmpoutpos += encodeEliasFano(data[from:to], base, uint(l), out, tmpoutpos)
+		}
+	}
+
+	inpos.Add(inlength)
+	outpos.Set(tmpoutpos)
+
+	return nil
+}
+
+func (this *PEF) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) (err error) {
+	if inlength == 0 {
+		return errors.New("pef/Uncompress: inlength = 0. No work done.")
+	}
+
+	defer encoding.Recover("pef/Uncompress", &err)
+
+	r, err := NewReader(in, inpos.Get())
+	if err != nil {
+		return errors.New("pef/Uncompress: " + err.Error())
+	}
+
+	tmpoutpos := outpos.Get()
+	for p := 0; p < r.numparts; p++ {
+		from, to, base, ptype, l, data := r.partition(p)
+
+		switch ptype {
+		case TypeRun:
+			last := r.last(p)
+			for i := 0; i < to-from; i++ {
+				out[tmpoutpos+i] = last - int32(to-from-1-i)
+			}
+		case TypeBitmap:
+			decodeBitmap(in, data, to-from, base, out, tmpoutpos)
+		case TypeEliasFano:
+			decodeEliasFano(in, data, to-from, base, l, out, tmpoutpos)
+		default:
+			return errors.New("pef/Uncompress: unknown partition type")
+		}
+
+		tmpoutpos += to - from
+	}
+
+	inpos.Set(r.end)
+	outpos.Set(tmpoutpos)
+
+	return nil
+}
+
+// partitionBase returns the value every element of the partition starting at from
+// is stored relative to.
+func partitionBase(data []int32, from int) int32 {
+	if from == 0 {
+		return data[0]
+	}
+	return data[from-1]
+}
+
+// partition splits data into partitions using dynamic programming over the candidate
+// boundaries (multiples of DefaultGranularity), minimizing the total encoded size.
+// It returns the list of boundaries, starting with 0 and ending with len(data).
+func partition(data []int32, bounds []int) []int {
+	n := len(data)
+	ncand := (n + DefaultGranularity - 1) / DefaultGranularity
+
+	// cand(k) is the k-th candidate boundary
+	cand := func(k int) int {
+		if k == ncand {
+			return n
+		}
+		return k * DefaultGranularity
+	}
+
+	// dups[i] is the number of i' < i for which data[i'] == data[i'-1]
+	dups := make([]int32, n+1)
+	for i := 1; i < n; i++ {
+		dups[i+1] = dups[i]
+		if data[i] == data[i-1] {
+			dups[i+1]++
+		}
+	}
+
+	costs := make([]int64, ncand+1)
+	prev := make([]int, ncand+1)
+	maxback := DefaultMaxPartitionSize / DefaultGranularity
+
+	for k := 1; k <= ncand; k++ {
+		to := cand(k)
+		costs[k] = -1
+
+		for j := k - 1; j >= 0 && j >= k-maxback; j-- {
+			from := cand(j)
+			strict := from+1 >= to || dups[to]-dups[from+1] == 0
+			_, _, c := partitionCost(data, from, to, partitionBase(data, from), strict)
+			c += costs[j] + DirectoryEntrySize*32
+
+			if costs[k] < 0 || c < costs[k] {
+				costs[k] = c
+				prev[k] = j
+			}
+		}
+	}
+
+	nparts := 0
+	for k := ncand; k > 0; k = prev[k] {
+		nparts++
+	}
+
+	bounds = append(bounds, make([]int, nparts+1)...)
+	for k, p := ncand, nparts; k > 0; k, p = prev[k], p-1 {
+		bounds[p] = cand(k)
+	}
+	bounds[0] = 0
+
+	return bounds
+}
+
+// bestType determines the cheapest representation for data[from:to]
+func bestType(data []int32, from, to int, base int32) (ptype int, l int, cost int64) {
+	strict := true
+	for i := from + 1; i < to; i++ {
+		if data[i] == data[i-1] {
+			strict = false
+			break
+		}
+	}
+
+	return partitionCost(data, from, to, base, strict)
+}
+
+// partitionCost returns the best partition type for data[from:to], along with the number
+// of low bits used by Elias-Fano, and the cost in bits. Every partition is word aligned.
+func partitionCost(data []int32, from, to int, base int32, strict bool) (ptype int, l int, cost int64) {
+	m := int64(to - from)
+	u := int64(uint32(data[to-1] - base))
+
+	// Elias-Fano
+	l = eliasFanoLowBits(u, m)
+	ptype = TypeEliasFano
+	cost = int64(numSkips(int(m)))*32 + words(m*int64(l)+(u>>uint(l))+m+1)*32
+
+	if strict {
+		if u-int64(uint32(data[from]-base)) == m-1 {
+			return TypeRun, 0, 0
+		}
+
+		if c := words(u+1) * 32; c <= cost {
+			ptype, l, cost = TypeBitmap, 0, c
+		}
+	}
+
+	return
+}
+
+func eliasFanoLowBits(u, m int64) int {
+	if u < m {
+		return 0
+	}
+	return bits.Len64(uint64(u/m)) - 1
+}
+
+func numSkips(m int) int {
+	return (m - 1) / SkipQuantum
+}
+
+func words(nbits int64) int64 {
+	return (nbits + 31) / 32
+}
+
+func clearWords(out []int32, outpos int, n int) {
+	for i := outpos; i < outpos+n; i++ {
+		out[i] = 0
+	}
+}
+
+// setBit sets the bit at position pos in the bit array starting at out[outpos].
+// Bits are numbered from the least significant bit of each word, as in bitpacking.
+func setBit(out []int32, outpos int, pos uint64) {
+	out[outpos+int(pos>>5)] |= int32(uint32(1) << (pos & 31))
+}
+
+// writeBits writes the lowest nbits (at most 32) bits of v at bit position pos
+func writeBits(out []int32, outpos int, pos uint64, v uint32, nbits uint) {
+	if nbits == 0 {
+		return
+	}
+
+	if nbits < 32 {
+		v &= 1<<nbits - 1
+	}
+
+	w := outpos + int(pos>>5)
+	shift := pos & 31
+	out[w] |= int32(v << shift)
+
+	if shift+uint64(nbits) > 32 {
+		out[w+1] |= int32(v >> (32 - shift))
+	}
+}
+
+// readBits reads nbits (at most 32) bits at bit position pos
+func readBits(in []int32, inpos int, pos uint64, nbits uint) uint32 {
+	if nbits == 0 {
+		return 0
+	}
+
+	w := inpos + int(pos>>5)
+	shift := pos & 31
+	v := uint32(in[w]) >> shift
+
+	if shift+uint64(nbits) > 32 {
+		v |= uint32(in[w+1]) << (32 - shift)
+	}
+
+	if nbits == 32 {
+		return v
+	}
+	return v & (1<<nbits - 1)
+}
+
+// selectBit returns the position of the rank-th (starting at 0) set bit at or after
+// bit position pos
+func selectBit(in []int32, inpos int, pos uint64, rank int) uint64 {
+	w := inpos + int(pos>>5)
+	word := uint32(in[w]) &^ (1<<(pos&31) - 1)
+
+	for {
+		c := bits.OnesCount32(word)
+		if rank < c {
+			for ; rank > 0; rank-- {
+				word &= word - 1
+			}
+			return uint64(w-inpos)<<5 + uint64(bits.TrailingZeros32(word))
+		}
+
+		rank -= c
+		w++
+		word = uint32(in[w])
+	}
+}
+
+func encodeBitmap(data []int32, base int32, out []int32, outpos int) int {
+	u := uint32(data[len(data)-1] - base)
+	n := int(words(int64(u) + 1))
+	clearWords(out, outpos, n)
+
+	for _, v := range data {
+		setBit(out, outpos, uint64(uint32(v-base)))
+	}
+
+	return n
+}
+
+func decodeBitmap(in []int32, inpos int, m int, base int32, out []int32, outpos int) {
+	for w, i := inpos, 0; i < m; w++ {
+		word := uint32(in[w])
+		for word != 0 {
+			out[outpos+i] = base + int32(uint32(w-inpos)<<5+uint32(bits.TrailingZeros32(word)))
+			word &= word - 1
+			i++
+		}
+	}
+}
+
+// encodeEliasFano writes the skip pointers, followed by the low bits and the high bits
+// of each element.
+func encodeEliasFano(data []int32, base int32, l uint, out []int32, outpos int) int {
+	m := len(data)
+	u := int64(uint32(data[m-1] - base))
+	skips := numSkips(m)
+	highpos := uint64(m) * uint64(l)
+	n := skips + int(words(int64(highpos)+(u>>l)+int64(m)+1))
+	clearWords(out, outpos, n)
+
+	bitpos := outpos + skips
+	for i, v := range data {
+		r := uint32(v - base)
+		writeBits(out, bitpos, uint64(i)*uint64(l), r, l)
+
+		high := uint64(r>>l) + uint64(i)
+		setBit(out, bitpos, highpos+high)
+
+		if i > 0 && i%SkipQuantum == 0 {
+			out[outpos+i/SkipQuantum-1] = int32(high)
+		}
+	}
+
+	return n
+}
+
+func decodeEliasFano(in []int32, inpos int, m int, base int32, l uint, out []int32, outpos int) {
+	bitpos := inpos + numSkips(m)
+	highpos := uint64(m) * uint64(l)
+
+	// The high bits are not word aligned, so the first word is shifted into place
+	w := bitpos + int(highpos>>5)
+	word := uint32(in[w]) &^ (1<<(highpos&31) - 1)
+	offset := uint64(w-bitpos)<<5 - highpos
+
+	for i := 0; i < m; {
+		for word != 0 {
+			high := offset + uint64(bits.TrailingZeros32(word)) - uint64(i)
+			low := readBits(in, bitpos, uint64(i)*uint64(l), l)
+			out[outpos+i] = base + int32(uint32(high)<<l|low)
+			word &= word - 1
+			i++
+			if i == m {
+				return
+			}
+		}
+
+		w++
+		word = uint32(in[w])
+		offset += 32
+	}
+}
+
+// Reader provides random access to data compressed by PEF, without decompressing it.
+type Reader struct {
+	in       []int32
+	n        int
+	numparts int
+	first    int32
+	dirpos   int
+	datapos  int
+	end      int
+}
+
+func NewReader(in []int32, inpos int) (*Reader, error) {
+	if len(in) < inpos+HeaderSize {
+		return nil, errors.New("pef/NewReader: input too short")
+	}
+
+	r := &Reader{
+		in:       in,
+		n:        int(in[inpos]),
+		numparts: int(in[inpos+1]),
+		first:    in[inpos+2],
+		dirpos:   inpos + HeaderSize,
+	}
+
+	if r.n < 0 || r.numparts < 0 || r.numparts > r.n {
+		return nil, errors.New("pef/NewReader: invalid header")
+	}
+
+	r.datapos = r.dirpos + r.numparts*DirectoryEntrySize
+	if len(in) < r.datapos {
+		return nil, errors.New("pef/NewReader: input too short")
+	}
+
+	r.end = r.datapos
+	if r.numparts > 0 {
+		// The end of the data is the end of the last partition
+		from, to, base, ptype, l, data := r.partition(r.numparts - 1)
+		m := to - from
+		switch ptype {
+		case TypeRun:
+			r.end = data
+		case TypeBitmap:
+			r.end = data + int(words(int64(uint32(r.last(r.numparts-1)-base))+1))
+		case TypeEliasFano:
+			u := int64(uint32(r.last(r.numparts-1) - base))
+			r.end = data + numSkips(m) + int(words(int64(m)*int64(l)+(u>>l)+int64(m)+1))
+		default:
+			return nil, errors.New("pef/NewReader: unknown partition type")
+		}
+
+		if r.end > len(in) || to != r.n {
+			return nil, errors.New("pef/NewReader: invalid partition directory")
+		}
+	}
+
+	return r, nil
+}
+
+// Len returns the number of integers
+func (this *Reader) Len() int {
+	return this.n
+}
+
+func (this *Reader) last(p int) int32 {
+	return this.in[this.dirpos+p*DirectoryEntrySize]
+}
+
+func (this *Reader) partitionEnd(p int) int {
+	return int(this.in[this.dirpos+p*DirectoryEntrySize+1])
+}
+
+// partition returns the element range [from, to) of partition p, its base value, type,
+// number of Elias-Fano low bits and the position of its data.
+func (this *Reader) partition(p int) (from, to int, base int32, ptype int, l uint, data int) {
+	d := this.dirpos + p*DirectoryEntrySize
+
+	if p > 0 {
+		from = this.partitionEnd(p - 1)
+		base = this.last(p - 1)
+	} else {
+		base = this.first
+	}
+
+	to = int(this.in[d+1])
+	data = this.datapos + int(this.in[d+2])
+	ptype = int(this.in[d+3] & 0xFF)
+	l = uint(this.in[d+3]>>8) & 31
+
+	return
+}
+
+// Get returns the i-th integer
+func (this *Reader) Get(i int) int32 {
+	// Find the first partition that ends after i
+	lo, hi := 0, this.numparts-1
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if this.partitionEnd(mid) <= i {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+
+	return this.get(lo, i)
+}
+
+func (this *Reader) get(p int, i int) int32 {
+	from, to, base, ptype, l, data := this.partition(p)
+	k := i - from
+
+	switch ptype {
+	case TypeRun:
+		return this.last(p) - int32(to-1-i)
+
+	case TypeBitmap:
+		return base + int32(selectBit(this.in, data, 0, k))
+
+	default:
+		m := to - from
+		bitpos := data + numSkips(m)
+		highpos := uint64(m) * uint64(l)
+
+		pos, rank := highpos, k
+		if s := k / SkipQuantum; s > 0 {
+			pos += uint64(uint32(this.in[data+s-1]))
+			rank -= s * SkipQuantum
+		}
+
+		high := selectBit(this.in, bitpos, pos, rank) - highpos - uint64(k)
+		low := readBits(this.in, bitpos, uint64(k)*uint64(l), l)
+		return base + int32(uint32(high)<<l|low)
+	}
+}
+
+// NextGEQ returns the index and the value of the first integer greater than or equal
+// to x. If there is none, ok is false.
+func (this *Reader) NextGEQ(x int32) (index int, value int32, ok bool) {
+	if this.numparts == 0 || this.last(this.numparts-1) < x {
+		return this.n, 0, false
+	}
+
+	// Find the first partition whose last element is >= x
+	lo, hi := 0, this.numparts-1
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if this.last(mid) < x {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+
+	from, to, _, _, _, _ := this.partition(lo)
+
+	// Binary search within the partition
+	a, b := from, to-1
+	for a < b {
+		mid := int(uint(a+b) >> 1)
+		if this.get(lo, mid) < x {
+			a = mid + 1
+		} else {
+			b = mid
+		}
+	}
+
+	return a, this.get(lo, a), true
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package pef
+
+import (
+	"log"
+	"testing"
+
+	"github.com/dataence/encoding/benchtools"
+	"github.com/dataence/encoding/cursor"
+	"github.com/dataence/encoding/generators"
+)
+
+var (
+	data []int32
+	size int = 128000
+)
+
+func init() {
+	log.Printf("pef/init: generating %d int32s\n", size)
+	data = generators.GenerateClustered(size, size*2)
+	log.Printf("pef/init: generated %d integers for test", size)
+}
+
+func TestCodec(t *testing.T) {
+	sizes := []int{128, 128 * 10, 128 * 100, 128 * 1000}
+	benchtools.TestCodec(New(), data, sizes)
+}
+
+func TestDuplicatesAndRuns(t *testing.T) {
+	in := make([]int32, 1000)
+	for i := range in {
+		switch {
+		case i < 300:
+			in[i] = int32(i)
+		case i < 600:
+			in[i] = 5000
+		default:
+			in[i] = int32(i * 1000)
+		}
+	}
+
+	benchtools.TestCodec(New(), in, []int{len(in)})
+}
+
+func TestSmall(t *testing.T) {
+	for _, k := range []int{1, 2, 63, 65, 100} {
+		out := make([]int32, 100)
+		outpos := cursor.New()
+		if err := New().Compress(data, cursor.New(), k, out, outpos); err != nil {
+			t.Fatal(err)
+		}
+
+		recov := make([]int32, k)
+		if err := New().Uncompress(out, cursor.New(), outpos.Get(), recov, cursor.New()); err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i < k; i++ {
+			if recov[i] != data[i] {
+				t.Fatalf("pef/TestSmall: Problem recovering. index = %d, in = %d, recovered = %d, original length = %d", i, data[i], recov[i], k)
+			}
+		}
+	}
+}
+
+func TestUnsorted(t *testing.T) {
+	in := []int32{1, 5, 3}
+	out := make([]int32, 100)
+	if err := New().Compress(in, cursor.New(), len(in), out, cursor.New()); err == nil {
+		t.Fatal("pef/TestUnsorted: expected error for unsorted input")
+	}
+}
+
+func TestReader(t *testing.T) {
+	length := 128 * 100
+	out := make([]int32, length*2)
+	if err := New().Compress(data, cursor.New(), length, out, cursor.New()); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewReader(out, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Len() != length {
+		t.Fatalf("pef/TestReader: Len() = %d, expected %d", r.Len(), length)
+	}
+
+	for i := 0; i < length; i++ {
+		if v := r.Get(i); v != data[i] {
+			t.Fatalf("pef/TestReader: Get(%d) = %d, expected %d", i, v, data[i])
+		}
+	}
+
+	for i := 0; i < length; i += 7 {
+		idx, v, ok := r.NextGEQ(data[i])
+		if !ok || idx != i || v != data[i] {
+			t.Fatalf("pef/TestReader: NextGEQ(%d) = %d, %d, %v, expected %d", data[i], idx, v, ok, i)
+		}
+
+		if i > 0 && data[i]-data[i-1] > 1 {
+			idx, v, ok = r.NextGEQ(data[i] - 1)
+			if !ok || idx != i || v != data[i] {
+				t.Fatalf("pef/TestReader: NextGEQ(%d) = %d, %d, %v, expected %d", data[i]-1, idx, v, ok, i)
+			}
+		}
+	}
+
+	if _, _, ok := r.NextGEQ(data[length-1] + 1); ok {
+		t.Fatalf("pef/TestReader: NextGEQ past the end should fail")
+	}
+}
+
+// go test -bench=Decode
+func BenchmarkDecode(b *testing.B) {
+	b.StopTimer()
+	length := 128 * 1024
+	data := generators.GenerateClustered(length, 1<<24)
+	compdata := make([]int32, 2*length)
+	recov := make([]int32, length)
+	inpos := cursor.New()
+	outpos := cursor.New()
+	codec := New()
+	codec.Compress(data, inpos, len(data), compdata, outpos)
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		newinpos := cursor.New()
+		newoutpos := cursor.New()
+		codec.Uncompress(compdata, newinpos, outpos.Get()-newinpos.Get(), recov, newoutpos)
+	}
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package rice is an implementation of the Golomb-Rice integer compression
+// algorithm in Go.
+// Each integer v is stored as v >> k in unary, followed by the k lowest bits of v.
+// The parameter k is chosen for each block of 128 integers so as to minimize the
+// size of the block.
+// It is mostly suitable for small integers following a geometric distribution,
+// such as the gaps of a random posting list or the exceptions of a patched codec.
+// For details, please see
+// Robert F. Rice, Some Practical Universal Noiseless Coding Techniques, JPL
+// Publication 79-22, 1979
+package rice
+
+import (
+	"errors"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/bitio"
+	"github.com/dataence/encoding/cursor"
+)
+
+const (
+	DefaultBlockSize = 128
+)
+
+type Rice struct {
+}
+
+var _ encoding.Integer = (*Rice)(nil)
+
+func New() encoding.Integer {
+	return &Rice{}
+}
+
+func (this *Rice) Compress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("rice/Compress: inlength = 0. No work done.")
+	}
+
+	out[outpos.Get()] = int32(inlength)
+	w := bitio.NewWriter(out, outpos.Get()+1)
+
+	s := inpos.Get()
+	finalinpos := s + inlength
+
+	for ; s < finalinpos; s += DefaultBlockSize {
+		e := s + DefaultBlockSize
+		if e > finalinpos {
+			e = finalinpos
+		}
+
+		k := OptimalK(in[s:e])
+		w.Write(k, 5)
+
+		for _, v := range in[s:e] {
+			w.WriteUnary(uint64(uint32(v) >> k))
+			w.Write(uint32(v), uint(k))
+		}
+	}
+
+	w.Flush()
+
+	inpos.Add(inlength)
+	outpos.Set(w.Pos())
+
+	return nil
+}
+
+func (this *Rice) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) (err error) {
+	if inlength == 0 {
+		return errors.New("rice/Uncompress: inlength = 0. No work done.")
+	}
+
+	defer encoding.Recover("rice/Uncompress", &err)
+
+	outlength := int(in[inpos.Get()])
+	r := bitio.NewReader(in, inpos.Get()+1)
+
+	s := outpos.Get()
+	finaloutpos := s + outlength
+
+	for ; s < finaloutpos; s += DefaultBlockSize {
+		e := s + DefaultBlockSize
+		if e > finaloutpos {
+			e = finaloutpos
+		}
+
+		k := uint(r.Read(5))
+
+		for i := s; i < e; i++ {
+			q := r.ReadUnary()
+			out[i] = int32(uint32(q)<<k | r.Read(k))
+		}
+	}
+
+	inpos.Set(r.Pos())
+	outpos.Add(outlength)
+
+	return nil
+}
+
+// OptimalK returns the parameter k, between 0 and 31, that minimizes the number of
+// bits needed to Rice encode buf
+func OptimalK(buf []int32) uint32 {
+	bestk := uint32(0)
+	bestcost := ^uint64(0)
+
+	for k := uint32(0); k < 32; k++ {
+		cost := uint64(len(buf)) * uint64(k+1)
+		for _, v := range buf {
+			cost += uint64(uint32(v) >> k)
+		}
+
+		if cost < bestcost {
+			bestcost = cost
+			bestk = k
+		}
+	}
+
+	return bestk
+}
@@ -0,0 +1,249 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package rle is an implementation of the hybrid run-length encoding / bit packing
+// integer compression algorithm in Go, as used by Apache Parquet.
+// Every integer is stored with the same bit width. Runs of at least 8 identical
+// integers are run-length encoded, the other integers are bit packed in groups of 8.
+// It is mostly suitable for arrays dominated by long runs, such as status codes
+// or dictionary indices.
+// For details, please see
+// https://github.com/apache/parquet-format/blob/master/Encodings.md#run-length-encoding--bit-packing-hybrid-rle--3
+package rle
+
+import (
+	"errors"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/cursor"
+)
+
+const (
+	// Minimum number of identical integers to be run-length encoded
+	MinRunLength = 8
+
+	// Number of int32s used by the header: length, bit width, number of bytes
+	HeaderSize = 3
+)
+
+// RLE codec structure: this is not thread-safe (need one per thread)
+type RLE struct {
+	// Working area
+	buf []byte
+}
+
+var _ encoding.Integer = (*RLE)(nil)
+
+func New() encoding.Integer {
+	return &RLE{}
+}
+
+func (this *RLE) Compress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("rle/Compress: inlength = 0. No work done.")
+	}
+
+	s := inpos.Get()
+	data := in[s : s+inlength]
+	bitwidth := uint(encoding.MaxBits(data))
+
+	this.buf = this.buf[:0]
+	literals := 0
+
+	for i := 0; i < len(data); {
+		run := 1
+		for i+run < len(data) && data[i+run] == data[i] {
+			run++
+		}
+
+		// Bit packed groups must be complete, so the pending literals borrow from the run
+		if run >= MinRunLength {
+			pad := (8 - literals%8) % 8
+			if literals == 0 || run-pad >= MinRunLength {
+				if literals > 0 {
+					this.buf = appendBitPacked(this.buf, data[i-literals:i+pad], bitwidth)
+					i += pad
+					run -= pad
+					literals = 0
+				}
+
+				this.buf = appendRun(this.buf, data[i], run, bitwidth)
+				i += run
+				continue
+			}
+		}
+
+		literals += run
+		i += run
+	}
+
+	if literals > 0 {
+		this.buf = appendBitPacked(this.buf, data[len(data)-literals:], bitwidth)
+	}
+
+	tmpoutpos := outpos.Get()
+	out[tmpoutpos] = int32(inlength)
+	out[tmpoutpos+1] = int32(bitwidth)
+	out[tmpoutpos+2] = int32(len(this.buf))
+	tmpoutpos += HeaderSize
+
+	// The bytes are stored with the first byte being the most significant byte of each int32
+	for i := 0; i < len(this.buf); i += 4 {
+		var v uint32
+		for j := 0; j < 4; j++ {
+			v <<= 8
+			if i+j < len(this.buf) {
+				v |= uint32(this.buf[i+j])
+			}
+		}
+		out[tmpoutpos] = int32(v)
+		tmpoutpos += 1
+	}
+
+	inpos.Add(inlength)
+	outpos.Set(tmpoutpos)
+
+	return nil
+}
+
+func (this *RLE) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) (err error) {
+	if inlength == 0 {
+		return errors.New("rle/Uncompress: inlength = 0. No work done.")
+	}
+
+	defer encoding.Recover("rle/Uncompress", &err)
+
+	tmpinpos := inpos.Get()
+	outlength := int(in[tmpinpos])
+	bitwidth := uint(in[tmpinpos+1])
+	bytesize := int(in[tmpinpos+2])
+	tmpinpos += HeaderSize
+
+	if bitwidth > 32 {
+		return errors.New("rle/Uncompress: invalid bit width")
+	}
+
+	bytes := in[tmpinpos:]
+	bp := 0
+	valuebytes := int(bitwidth+7) / 8
+
+	s := outpos.Get()
+	finaloutpos := s + outlength
+
+	for s < finaloutpos {
+		if bp >= bytesize {
+			return errors.New("rle/Uncompress: unexpected end of data")
+		}
+
+		header := uint32(0)
+		for shift := uint(0); ; shift += 7 {
+			c := grabByte(bytes, bp)
+			bp++
+			header |= uint32(c&127) << shift
+			if c&128 == 0 {
+				break
+			}
+		}
+
+		if header&1 == 0 {
+			// Run-length encoded run
+			run := int(header >> 1)
+			v := uint32(0)
+			for j := 0; j < valuebytes; j++ {
+				v |= uint32(grabByte(bytes, bp)) << (8 * uint(j))
+				bp++
+			}
+
+			if run > finaloutpos-s {
+				return errors.New("rle/Uncompress: run too long")
+			}
+
+			for j := 0; j < run; j++ {
+				out[s+j] = int32(v)
+			}
+			s += run
+		} else {
+			// Bit packed groups of 8 integers
+			groups := int(header >> 1)
+			if groups*8 > finaloutpos-s+7 {
+				return errors.New("rle/Uncompress: too many groups")
+			}
+
+			acc := uint64(0)
+			nbits := uint(0)
+			mask := uint64(1)<<bitwidth - 1
+
+			for j := 0; j < groups*8; j++ {
+				for nbits < bitwidth {
+					acc |= uint64(grabByte(bytes, bp)) << nbits
+					bp++
+					nbits += 8
+				}
+
+				// The last group is padded
+				if s < finaloutpos {
+					out[s] = int32(acc & mask)
+					s++
+				}
+
+				acc >>= bitwidth
+				nbits -= bitwidth
+			}
+		}
+	}
+
+	inpos.Set(tmpinpos + (bytesize+3)/4)
+	outpos.Set(finaloutpos)
+
+	return nil
+}
+
+func grabByte(in []int32, index int) byte {
+	return byte(in[index/4] >> uint(24-(index%4)*8))
+}
+
+func appendVarint(buf []byte, v uint32) []byte {
+	for v >= 0x80 {
+		buf = append(buf, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(buf, byte(v))
+}
+
+// appendRun appends a run-length encoded run, with the value stored in as few bytes
+// as the bit width allows, least significant byte first.
+func appendRun(buf []byte, v int32, run int, bitwidth uint) []byte {
+	buf = appendVarint(buf, uint32(run)<<1)
+	for j := uint(0); j < (bitwidth+7)/8; j++ {
+		buf = append(buf, byte(uint32(v)>>(8*j)))
+	}
+	return buf
+}
+
+// appendBitPacked appends the integers bit packed in groups of 8, padding the last group
+// with zeros. The integers are packed starting with the least significant bit.
+func appendBitPacked(buf []byte, data []int32, bitwidth uint) []byte {
+	groups := (len(data) + 7) / 8
+	buf = appendVarint(buf, uint32(groups)<<1|1)
+
+	acc := uint64(0)
+	nbits := uint(0)
+
+	for j := 0; j < groups*8; j++ {
+		if j < len(data) {
+			acc |= uint64(uint32(data[j])) << nbits
+		}
+		nbits += bitwidth
+
+		for nbits >= 8 {
+			buf = append(buf, byte(acc))
+			acc >>= 8
+			nbits -= 8
+		}
+	}
+
+	return buf
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package rle
+
+import (
+	"log"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/dataence/encoding/benchtools"
+	"github.com/dataence/encoding/cursor"
+	"github.com/dataence/encoding/generators"
+)
+
+var (
+	data []int32
+	size int = 128000
+)
+
+func init() {
+	log.Printf("rle/init: generating %d int32s\n", size)
+	data = generators.GenerateClustered(size, size*2)
+	log.Printf("rle/init: generated %d integers for test", size)
+}
+
+func TestCodec(t *testing.T) {
+	sizes := []int{100, 128, 128 * 10, 128 * 100, 128 * 1000}
+	benchtools.TestCodec(New(), data, sizes)
+}
+
+func TestStatusCodes(t *testing.T) {
+	// Long runs of 200s, broken up by short bursts of errors
+	r := rand.New(rand.NewSource(1))
+	codes := []int32{200, 301, 404, 500, 503}
+	in := make([]int32, 0, 100000)
+	for len(in) < cap(in)-1000 {
+		for k := r.Intn(1000); k > 0; k-- {
+			in = append(in, 200)
+		}
+		for k := r.Intn(12); k > 0; k-- {
+			in = append(in, codes[r.Intn(len(codes))])
+		}
+	}
+
+	benchtools.TestCodec(New(), in, []int{len(in)})
+
+	_, out, err := benchtools.Compress(New(), in, len(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bits := float64(len(out)*32) / float64(len(in)); bits > 0.5 {
+		t.Fatalf("rle/TestStatusCodes: %f bits per integer", bits)
+	}
+}
+
+func TestExtremes(t *testing.T) {
+	in := []int32{0, 0, 0, 0, 0, 0, 0, 0, 0, -1, math.MinInt32, math.MaxInt32, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 7}
+	for _, k := range []int{1, 7, 8, 9, 10, 12, len(in)} {
+		out := make([]int32, 100)
+		outpos := cursor.New()
+		if err := New().Compress(in, cursor.New(), k, out, outpos); err != nil {
+			t.Fatal(err)
+		}
+
+		recov := make([]int32, k)
+		if err := New().Uncompress(out, cursor.New(), outpos.Get(), recov, cursor.New()); err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i < k; i++ {
+			if recov[i] != in[i] {
+				t.Fatalf("rle/TestExtremes: Problem recovering. index = %d, in = %d, recovered = %d, original length = %d", i, in[i], recov[i], k)
+			}
+		}
+	}
+
+	zeros := make([]int32, 1000)
+	benchtools.TestCodec(New(), zeros, []int{len(zeros)})
+}
+
+// go test -bench=Decode
+func BenchmarkDecode(b *testing.B) {
+	b.StopTimer()
+	length := 128 * 1024
+	data := generators.GenerateClustered(length, 1<<24)
+	compdata := make([]int32, 2*length)
+	recov := make([]int32, length)
+	inpos := cursor.New()
+	outpos := cursor.New()
+	codec := New()
+	codec.Compress(data, inpos, len(data), compdata, outpos)
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		newinpos := cursor.New()
+		newoutpos := cursor.New()
+		codec.Uncompress(compdata, newinpos, outpos.Get()-newinpos.Get(), recov, newoutpos)
+	}
+}
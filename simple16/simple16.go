@@ -0,0 +1,171 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package simple16 is an implementation of the Simple16 integer compression
+// algorithm in Go.
+// Each int32 holds a 4-bit selector followed by 28 bits of data, which contain
+// from 1 integer of 28 bits up to 28 integers of 1 bit, using one of 16 layouts.
+// Every integer must be within [0, 2^28).
+// It is mostly suitable for short arrays of small integers, such as the exceptions
+// of a patched codec.
+// For details, please see
+// Jiangong Zhang, Xiaohui Long and Torsten Suel, Performance of compressed inverted
+// list caching in search engines, WWW 2008 http://dx.doi.org/10.1145/1367497.1367550
+package simple16
+
+import (
+	"errors"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/cursor"
+)
+
+const (
+	// Largest integer that can be stored
+	MaxValue = 1<<28 - 1
+)
+
+// Number of bits of each integer for each selector
+var layouts = [16][]uint{
+	repeat(28, 1),
+	append(repeat(7, 2), repeat(14, 1)...),
+	append(append(repeat(7, 1), repeat(7, 2)...), repeat(7, 1)...),
+	append(repeat(14, 1), repeat(7, 2)...),
+	repeat(14, 2),
+	append(repeat(1, 4), repeat(8, 3)...),
+	append(append(repeat(1, 3), repeat(4, 4)...), repeat(3, 3)...),
+	repeat(7, 4),
+	append(repeat(4, 5), repeat(2, 4)...),
+	append(repeat(2, 4), repeat(4, 5)...),
+	append(repeat(3, 6), repeat(2, 5)...),
+	append(repeat(2, 5), repeat(3, 6)...),
+	repeat(4, 7),
+	append(repeat(1, 10), repeat(2, 9)...),
+	repeat(2, 14),
+	repeat(1, 28),
+}
+
+func repeat(n int, bits uint) []uint {
+	r := make([]uint, n)
+	for i := range r {
+		r[i] = bits
+	}
+	return r
+}
+
+type Simple16 struct {
+}
+
+var _ encoding.Integer = (*Simple16)(nil)
+
+func New() encoding.Integer {
+	return &Simple16{}
+}
+
+func (this *Simple16) Compress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("simple16/Compress: inlength = 0. No work done.")
+	}
+
+	out[outpos.Get()] = int32(inlength)
+	tmpoutpos := outpos.Get() + 1
+
+	data := in[inpos.Get() : inpos.Get()+inlength]
+	for len(data) > 0 {
+		word, n, err := Pack(data)
+		if err != nil {
+			return errors.New("simple16/Compress: " + err.Error())
+		}
+
+		out[tmpoutpos] = int32(word)
+		tmpoutpos += 1
+		data = data[n:]
+	}
+
+	inpos.Add(inlength)
+	outpos.Set(tmpoutpos)
+
+	return nil
+}
+
+func (this *Simple16) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) (err error) {
+	if inlength == 0 {
+		return errors.New("simple16/Uncompress: inlength = 0. No work done.")
+	}
+
+	defer encoding.Recover("simple16/Uncompress", &err)
+
+	tmpinpos := inpos.Get()
+	outlength := int(in[tmpinpos])
+	tmpinpos += 1
+
+	tmpoutpos := outpos.Get()
+	data := out[tmpoutpos : tmpoutpos+outlength]
+	for len(data) > 0 {
+		if tmpinpos >= len(in) {
+			return errors.New("simple16/Uncompress: unexpected end of data")
+		}
+
+		n := Unpack(uint32(in[tmpinpos]), data)
+		tmpinpos += 1
+		data = data[n:]
+	}
+
+	inpos.Set(tmpinpos)
+	outpos.Add(outlength)
+
+	return nil
+}
+
+// Pack packs as many integers from the beginning of in as possible into a single
+// int32, and returns it along with the number of integers packed. The last int32 of
+// an array may hold fewer integers than its layout.
+func Pack(in []int32) (uint32, int, error) {
+	for sel, layout := range layouts {
+		n := len(layout)
+		if n > len(in) {
+			n = len(in)
+		}
+
+		word := uint32(sel) << 28
+		shift := uint(0)
+		fits := true
+
+		for i, v := range in[:n] {
+			if uint32(v)>>layout[i] != 0 {
+				fits = false
+				break
+			}
+
+			word |= uint32(v) << shift
+			shift += layout[i]
+		}
+
+		if fits {
+			return word, n, nil
+		}
+	}
+
+	return 0, 0, errors.New("integer out of range")
+}
+
+// Unpack writes the integers packed in word to out, at most len(out) of them, and
+// returns how many were written
+func Unpack(word uint32, out []int32) int {
+	layout := layouts[word>>28]
+	n := len(layout)
+	if n > len(out) {
+		n = len(out)
+	}
+
+	shift := uint(0)
+	for i := range out[:n] {
+		out[i] = int32((word >> shift) & (1<<layout[i] - 1))
+		shift += layout[i]
+	}
+
+	return n
+}
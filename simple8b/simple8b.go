@@ -0,0 +1,226 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package simple8b is an implementation of the Simple-8b integer compression
+// algorithm in Go, for int64s.
+// Each 64-bit word holds a 4-bit selector followed by 60 bits of data, which contain
+// from 1 integer of 60 bits up to 60 integers of 1 bit. The selectors 0 and 1 are
+// used for runs of 240 and 120 ones respectively, as done by InfluxDB.
+// Every integer must be within [0, 2^60). The compressed words are stored as two
+// int32s each, the most significant one first.
+// For details, please see
+// Vo Ngoc Anh and Alistair Moffat, Index compression using 64-bit words,
+// Software: Practice and Experience 40(2), 2010 http://dx.doi.org/10.1002/spe.948
+package simple8b
+
+import (
+	"errors"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/cursor"
+)
+
+const (
+	// Largest integer that can be stored
+	MaxValue = 1<<60 - 1
+)
+
+// Number of integers and bits per integer for each selector
+var selectors = [16]struct {
+	n    int
+	bits uint
+}{
+	{240, 0}, {120, 0}, {60, 1}, {30, 2}, {20, 3}, {15, 4}, {12, 5}, {10, 6},
+	{8, 7}, {7, 8}, {6, 10}, {5, 12}, {4, 15}, {3, 20}, {2, 30}, {1, 60},
+}
+
+// Simple8b codec structure: this is not thread-safe (need one per thread)
+type Simple8b struct {
+	// Working area
+	buf []uint64
+}
+
+var _ encoding.Integer64 = (*Simple8b)(nil)
+
+func New() encoding.Integer64 {
+	return &Simple8b{}
+}
+
+func (this *Simple8b) Compress(in []int64, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("simple8b/Compress: inlength = 0. No work done.")
+	}
+
+	this.buf = Grow(this.buf, inlength)
+	for i, v := range in[inpos.Get() : inpos.Get()+inlength] {
+		this.buf[i] = uint64(v)
+	}
+
+	out[outpos.Get()] = int32(inlength)
+	tmpoutpos, err := EncodeAll(this.buf, out, outpos.Get()+1)
+	if err != nil {
+		return errors.New("simple8b/Compress: " + err.Error())
+	}
+
+	inpos.Add(inlength)
+	outpos.Set(tmpoutpos)
+
+	return nil
+}
+
+func (this *Simple8b) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int64, outpos *cursor.Cursor) (err error) {
+	if inlength == 0 {
+		return errors.New("simple8b/Uncompress: inlength = 0. No work done.")
+	}
+
+	defer encoding.Recover("simple8b/Uncompress", &err)
+
+	outlength := int(in[inpos.Get()])
+	if outlength < 0 || outlength > len(out)-outpos.Get() {
+		return errors.New("simple8b/Uncompress: invalid length. Data may be corrupted.")
+	}
+	this.buf = Grow(this.buf, outlength)
+
+	tmpinpos, err := DecodeAll(in, inpos.Get()+1, this.buf)
+	if err != nil {
+		return errors.New("simple8b/Uncompress: " + err.Error())
+	}
+
+	tmpoutpos := outpos.Get()
+	for i, v := range this.buf {
+		out[tmpoutpos+i] = int64(v)
+	}
+
+	inpos.Set(tmpinpos)
+	outpos.Add(outlength)
+
+	return nil
+}
+
+// EncodeAll packs all the integers of in into 64-bit words, written as pairs of int32s
+// to out starting at outpos. It returns the position following the last word written.
+func EncodeAll(in []uint64, out []int32, outpos int) (int, error) {
+	for len(in) > 0 {
+		word, n, err := Pack(in)
+		if err != nil {
+			return outpos, err
+		}
+
+		out[outpos] = int32(word >> 32)
+		out[outpos+1] = int32(word)
+		outpos += 2
+		in = in[n:]
+	}
+
+	return outpos, nil
+}
+
+// DecodeAll fills out with the integers packed in the words of in starting at inpos.
+// It returns the position following the last word read.
+func DecodeAll(in []int32, inpos int, out []uint64) (int, error) {
+	for len(out) > 0 {
+		if inpos+1 >= len(in) {
+			return inpos, errors.New("unexpected end of data")
+		}
+
+		word := uint64(uint32(in[inpos]))<<32 | uint64(uint32(in[inpos+1]))
+		inpos += 2
+
+		n, err := Unpack(word, out)
+		if err != nil {
+			return inpos, err
+		}
+
+		out = out[n:]
+	}
+
+	return inpos, nil
+}
+
+// Pack packs as many integers from the beginning of in as possible into a single word,
+// and returns it along with the number of integers packed.
+func Pack(in []uint64) (uint64, int, error) {
+	for sel := range selectors {
+		n, bits := selectors[sel].n, selectors[sel].bits
+		if n > len(in) {
+			continue
+		}
+
+		if bits == 0 {
+			if !allOnes(in[:n]) {
+				continue
+			}
+
+			return uint64(sel) << 60, n, nil
+		}
+
+		if !fits(in[:n], bits) {
+			continue
+		}
+
+		word := uint64(sel) << 60
+		for i, v := range in[:n] {
+			word |= v << (uint(i) * bits)
+		}
+
+		return word, n, nil
+	}
+
+	return 0, 0, errors.New("integer out of range")
+}
+
+// Unpack writes the integers packed in word to out, and returns how many there were
+func Unpack(word uint64, out []uint64) (int, error) {
+	sel := word >> 60
+	n, bits := selectors[sel].n, selectors[sel].bits
+	if n > len(out) {
+		return 0, errors.New("too many integers in word")
+	}
+
+	if bits == 0 {
+		for i := range out[:n] {
+			out[i] = 1
+		}
+
+		return n, nil
+	}
+
+	mask := uint64(1)<<bits - 1
+	for i := range out[:n] {
+		out[i] = (word >> (uint(i) * bits)) & mask
+	}
+
+	return n, nil
+}
+
+// Grow returns buf resized to n integers, reallocating it if it is too small
+func Grow(buf []uint64, n int) []uint64 {
+	if cap(buf) < n {
+		return make([]uint64, n)
+	}
+
+	return buf[:n]
+}
+
+func allOnes(in []uint64) bool {
+	for _, v := range in {
+		if v != 1 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func fits(in []uint64, bits uint) bool {
+	for _, v := range in {
+		if v>>bits != 0 {
+			return false
+		}
+	}
+
+	return true
+}
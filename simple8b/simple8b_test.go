@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package simple8b
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dataence/encoding/cursor"
+)
+
+func roundTrip(t *testing.T, in []int64) int {
+	out := make([]int32, 2*len(in)+1)
+	outpos := cursor.New()
+	if err := New().Compress(in, cursor.New(), len(in), out, outpos); err != nil {
+		t.Fatal(err)
+	}
+
+	recov := make([]int64, len(in))
+	inpos := cursor.New()
+	if err := New().Uncompress(out, inpos, outpos.Get(), recov, cursor.New()); err != nil {
+		t.Fatal(err)
+	}
+
+	if inpos.Get() != outpos.Get() {
+		t.Fatalf("simple8b/roundTrip: read %d int32s, wrote %d", inpos.Get(), outpos.Get())
+	}
+
+	for i := range in {
+		if recov[i] != in[i] {
+			t.Fatalf("simple8b/roundTrip: Problem recovering. index = %d, in = %d, recovered = %d, original length = %d", i, in[i], recov[i], len(in))
+		}
+	}
+
+	return outpos.Get()
+}
+
+func TestCodec(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 100, 1000, 100000} {
+		in := make([]int64, n)
+		for i := range in {
+			in[i] = r.Int63n(1 << uint(r.Intn(61)))
+		}
+
+		roundTrip(t, in)
+	}
+}
+
+func TestSelectors(t *testing.T) {
+	for sel, s := range selectors {
+		max := int64(1)
+		if s.bits > 0 {
+			max = 1<<s.bits - 1
+		}
+
+		in := make([]int64, s.n)
+		for i := range in {
+			in[i] = max
+		}
+
+		// The length and one word
+		if size := roundTrip(t, in); size != 3 {
+			t.Fatalf("simple8b/TestSelectors: selector %d compressed to %d int32s", sel, size)
+		}
+	}
+}
+
+func TestOutOfRange(t *testing.T) {
+	out := make([]int32, 100)
+	for _, v := range []int64{-1, MaxValue + 1} {
+		in := []int64{0, v}
+		if err := New().Compress(in, cursor.New(), len(in), out, cursor.New()); err == nil {
+			t.Fatalf("simple8b/TestOutOfRange: expected error for %d", v)
+		}
+	}
+
+	roundTrip(t, []int64{0, MaxValue, 1})
+}
+
+// go test -bench=Decode
+func BenchmarkDecode(b *testing.B) {
+	b.StopTimer()
+	length := 128 * 1024
+	r := rand.New(rand.NewSource(1))
+	data := make([]int64, length)
+	for i := range data {
+		data[i] = r.Int63n(1 << 20)
+	}
+	compdata := make([]int32, 2*length+1)
+	recov := make([]int64, length)
+	inpos := cursor.New()
+	outpos := cursor.New()
+	codec := New()
+	codec.Compress(data, inpos, len(data), compdata, outpos)
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		newinpos := cursor.New()
+		newoutpos := cursor.New()
+		codec.Uncompress(compdata, newinpos, outpos.Get()-newinpos.Get(), recov, newoutpos)
+	}
+}
@@ -0,0 +1,309 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package simplepfor is an implementation of the SimplePFOR integer compression
+// algorithm in Go.
+// It is a variant of fastpfor where the exceptions of a page are not bit packed
+// per width, but compressed all together with a separate exception coder, Simple16
+// by default. It is mostly suitable for arrays of small integers with a heavy tail.
+// For details, please see
+// Daniel Lemire and Leonid Boytsov, Decoding billions of integers per second
+// through vectorization Software: Practice & Experience
+// http://onlinelibrary.wiley.com/doi/10.1002/spe.2203/abstract or
+// http://arxiv.org/abs/1209.2137
+package simplepfor
+
+import (
+	"errors"
+
+	"github.com/dataence/bytebuffer"
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/bitpacking"
+	"github.com/dataence/encoding/cursor"
+	"github.com/dataence/encoding/simple16"
+	"github.com/dataence/encoding/variablebyte"
+)
+
+const (
+	DefaultBlockSize = 128
+	DefaultPageSize  = 65536
+
+	// Cost in bits of the position of each exception
+	OverheadOfEachExcept = 8
+)
+
+// ExceptionCoder selects how the exceptions of a page are compressed
+type ExceptionCoder int
+
+const (
+	Simple16 ExceptionCoder = iota
+	VariableByte
+)
+
+// SimplePFOR codec structure: this is not thread-safe (need one per thread)
+type SimplePFOR struct {
+	exceptions encoding.Integer
+
+	// Largest number of bits of an exception supported by the exception coder
+	maxExceptBits int32
+
+	// Estimated cost in bits of an exception of the given number of bits
+	exceptCost func(bits int32) int32
+
+	// Working area
+	byteContainer *bytebuffer.ByteBuffer
+	exceptBuf     []int32
+	freqs         []int32
+}
+
+var _ encoding.Integer = (*SimplePFOR)(nil)
+
+// New returns a SimplePFOR codec using Simple16 for the exceptions
+func New() encoding.Integer {
+	return NewWithExceptionCoder(Simple16)
+}
+
+func NewWithExceptionCoder(coder ExceptionCoder) encoding.Integer {
+	s := &SimplePFOR{
+		byteContainer: bytebuffer.NewByteBuffer(3*DefaultPageSize/DefaultBlockSize + DefaultPageSize),
+		exceptBuf:     make([]int32, DefaultPageSize),
+		freqs:         make([]int32, 33),
+	}
+
+	switch coder {
+	case VariableByte:
+		s.exceptions = variablebyte.New()
+		s.maxExceptBits = 32
+		s.exceptCost = func(bits int32) int32 { return 8 * ((bits + 6) / 7) }
+	default:
+		s.exceptions = simple16.New()
+		s.maxExceptBits = 28
+		s.exceptCost = func(bits int32) int32 { return bits }
+	}
+
+	return s
+}
+
+func (this *SimplePFOR) Compress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	inlength = encoding.FloorBy(inlength, DefaultBlockSize)
+	if inlength == 0 {
+		return errors.New("simplepfor/Compress: inlength = 0. No work done.")
+	}
+
+	out[outpos.Get()] = int32(inlength)
+	outpos.Increment()
+
+	finalinpos := inpos.Get() + inlength
+	for inpos.Get() != finalinpos {
+		thissize := finalinpos - inpos.Get()
+		if thissize > DefaultPageSize {
+			thissize = DefaultPageSize
+		}
+
+		if err := this.encodePage(in, inpos, thissize, out, outpos); err != nil {
+			return errors.New("simplepfor/Compress: " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (this *SimplePFOR) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) (err error) {
+	if inlength == 0 {
+		return errors.New("simplepfor/Uncompress: inlength = 0. No work done.")
+	}
+
+	defer encoding.Recover("simplepfor/Uncompress", &err)
+
+	outlength := int(in[inpos.Get()])
+	inpos.Increment()
+	if outlength < 0 || outlength > len(out)-outpos.Get() {
+		return errors.New("simplepfor/Uncompress: invalid length. Data may be corrupted.")
+	}
+
+	finaloutpos := outpos.Get() + outlength
+	for outpos.Get() != finaloutpos {
+		thissize := finaloutpos - outpos.Get()
+		if thissize > DefaultPageSize {
+			thissize = DefaultPageSize
+		}
+
+		if err := this.decodePage(in, inpos, out, outpos, thissize); err != nil {
+			return errors.New("simplepfor/Uncompress: " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+// getBestBFromData determines the bit width with the lowest estimated cost, along
+// with the number of exceptions, and the max bit width of the array of int32s
+func (this *SimplePFOR) getBestBFromData(in []int32) (bestb int32, bestc int32, maxb int32) {
+	for i := range this.freqs {
+		this.freqs[i] = 0
+	}
+
+	for _, v := range in {
+		this.freqs[encoding.LeadingBitPosition(uint32(v))]++
+	}
+
+	maxb = 32
+	for this.freqs[maxb] == 0 && maxb > 0 {
+		maxb--
+	}
+
+	bestb = maxb
+	bestCost := maxb * DefaultBlockSize
+	cexcept := int32(0)
+
+	for b := maxb - 1; b >= 0 && maxb-b <= this.maxExceptBits; b-- {
+		cexcept += this.freqs[b+1]
+		thisCost := cexcept*(OverheadOfEachExcept+this.exceptCost(maxb-b)) + b*DefaultBlockSize
+		if thisCost < bestCost {
+			bestCost = thisCost
+			bestb = b
+			bestc = cexcept
+		}
+	}
+
+	return
+}
+
+// encodePage writes [offset of the metadata, packed blocks, byte size, bytes,
+// number of exceptions, size of the exceptions, exceptions]. For each block, the
+// bytes hold the bit width, the number of exceptions and their positions.
+func (this *SimplePFOR) encodePage(in []int32, inpos *cursor.Cursor, thissize int, out []int32, outpos *cursor.Cursor) error {
+	headerpos := outpos.Get()
+	tmpoutpos := headerpos + 1
+	tmpinpos := inpos.Get()
+	finalinpos := tmpinpos + thissize
+
+	this.byteContainer.Clear()
+	exceptions := this.exceptBuf[:0]
+
+	for ; tmpinpos < finalinpos; tmpinpos += DefaultBlockSize {
+		bestb, bestc, _ := this.getBestBFromData(in[tmpinpos : tmpinpos+DefaultBlockSize])
+		this.byteContainer.Put(byte(bestb))
+		this.byteContainer.Put(byte(bestc))
+
+		if bestc > 0 {
+			for k := 0; k < DefaultBlockSize; k++ {
+				if uint32(in[tmpinpos+k])>>uint(bestb) != 0 {
+					this.byteContainer.Put(byte(k))
+					exceptions = append(exceptions, int32(uint32(in[tmpinpos+k])>>uint(bestb)))
+				}
+			}
+		}
+
+		for k := 0; k < DefaultBlockSize; k += 32 {
+			bitpacking.FastPack(in, tmpinpos+k, out, tmpoutpos, int(bestb))
+			tmpoutpos += int(bestb)
+		}
+	}
+
+	out[headerpos] = int32(tmpoutpos - headerpos)
+
+	bytesize := this.byteContainer.Position()
+	for this.byteContainer.Position()&3 != 0 {
+		this.byteContainer.Put(0)
+	}
+
+	out[tmpoutpos] = int32(bytesize)
+	tmpoutpos += 1
+	howmanyints := (bytesize + 3) / 4
+	this.byteContainer.Flip()
+	this.byteContainer.AsInt32Buffer().GetInt32s(out, tmpoutpos, howmanyints)
+	tmpoutpos += howmanyints
+
+	out[tmpoutpos] = int32(len(exceptions))
+	out[tmpoutpos+1] = 0
+	tmpoutpos += 2
+
+	if len(exceptions) > 0 {
+		exceptpos := cursor.New()
+		exceptpos.Set(tmpoutpos)
+		if err := this.exceptions.Compress(exceptions, cursor.New(), len(exceptions), out, exceptpos); err != nil {
+			return err
+		}
+
+		out[tmpoutpos-1] = int32(exceptpos.Get() - tmpoutpos)
+		tmpoutpos = exceptpos.Get()
+	}
+
+	this.exceptBuf = exceptions
+	inpos.Set(tmpinpos)
+	outpos.Set(tmpoutpos)
+
+	return nil
+}
+
+func grabByte(in []int32, index int) byte {
+	return byte(in[index/4] >> uint(24-(index%4)*8))
+}
+
+func (this *SimplePFOR) decodePage(in []int32, inpos *cursor.Cursor, out []int32, outpos *cursor.Cursor, thissize int) error {
+	headerpos := inpos.Get()
+	tmpinpos := headerpos + 1
+
+	wheremeta := headerpos + int(in[headerpos])
+	bytesize := int(in[wheremeta])
+	bytearray := in[wheremeta+1:]
+	mybp := 0
+
+	inexcept := wheremeta + 1 + (bytesize+3)/4
+	numexcept := int(in[inexcept])
+	exceptsize := int(in[inexcept+1])
+	inexcept += 2
+
+	if numexcept < 0 || numexcept > thissize {
+		return errors.New("invalid number of exceptions")
+	}
+
+	if numexcept > len(this.exceptBuf) {
+		this.exceptBuf = make([]int32, numexcept)
+	}
+	exceptions := this.exceptBuf[:numexcept]
+
+	if numexcept > 0 {
+		exceptinpos := cursor.New()
+		exceptinpos.Set(inexcept)
+		exceptpos := cursor.New()
+		if err := this.exceptions.Uncompress(in, exceptinpos, exceptsize, exceptions, exceptpos); err != nil {
+			return err
+		}
+
+		if exceptpos.Get() != numexcept {
+			return errors.New("wrong number of exceptions")
+		}
+	}
+
+	tmpoutpos := outpos.Get()
+	finaloutpos := tmpoutpos + thissize
+	e := 0
+
+	for ; tmpoutpos < finaloutpos; tmpoutpos += DefaultBlockSize {
+		bestb := int(grabByte(bytearray, mybp))
+		cexcept := int(grabByte(bytearray, mybp+1))
+		mybp += 2
+
+		for k := 0; k < DefaultBlockSize; k += 32 {
+			bitpacking.FastUnpack(in, tmpinpos, out, tmpoutpos+k, bestb)
+			tmpinpos += bestb
+		}
+
+		for k := 0; k < cexcept; k++ {
+			pos := int(grabByte(bytearray, mybp))
+			mybp++
+			out[tmpoutpos+pos] |= exceptions[e] << uint(bestb)
+			e++
+		}
+	}
+
+	inpos.Set(inexcept + exceptsize)
+	outpos.Set(tmpoutpos)
+
+	return nil
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package simplepfor
+
+import (
+	"log"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/dataence/encoding/benchtools"
+	"github.com/dataence/encoding/cursor"
+	"github.com/dataence/encoding/generators"
+)
+
+var (
+	data []int32
+	size int = 128000
+)
+
+func init() {
+	log.Printf("simplepfor/init: generating %d int32s\n", size)
+	data = generators.GenerateClustered(size, size*2)
+	log.Printf("simplepfor/init: generated %d integers for test", size)
+}
+
+func TestCodec(t *testing.T) {
+	sizes := []int{128, 128 * 10, 128 * 100, 128 * 1000}
+	benchtools.TestCodec(New(), data, sizes)
+	benchtools.TestCodec(NewWithExceptionCoder(VariableByte), data, sizes)
+}
+
+func TestHeavyTail(t *testing.T) {
+	// Mostly small integers, with a Pareto tail reaching the full 32 bits
+	r := rand.New(rand.NewSource(1))
+	in := make([]int32, size)
+	for i := range in {
+		v := math.Pow(r.Float64(), -4)
+		if v > math.MaxUint32 {
+			v = math.MaxUint32
+		}
+		in[i] = int32(uint32(v))
+	}
+
+	sizes := []int{128, 128 * 10, 128 * 1000}
+	benchtools.TestCodec(New(), in, sizes)
+	benchtools.TestCodec(NewWithExceptionCoder(VariableByte), in, sizes)
+}
+
+// go test -bench=Decode
+func BenchmarkDecode(b *testing.B) {
+	b.StopTimer()
+	length := 128 * 1024
+	data := generators.GenerateClustered(length, 1<<24)
+	compdata := make([]int32, 2*length)
+	recov := make([]int32, length)
+	inpos := cursor.New()
+	outpos := cursor.New()
+	codec := New()
+	codec.Compress(data, inpos, len(data), compdata, outpos)
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		newinpos := cursor.New()
+		newoutpos := cursor.New()
+		codec.Uncompress(compdata, newinpos, outpos.Get()-newinpos.Get(), recov, newoutpos)
+	}
+}
@@ -0,0 +1,450 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package sortedset implements a set of integers held compressed in memory, suitable
+// for sparse sets such as sets of identifiers, for which a bitset is too large.
+// The integers are kept sorted, in 128-integer blocks compressed with a delta codec,
+// delta BP32 by default, starting from the minimum of the block. The minimum and
+// maximum of every block are kept uncompressed, so that lookups and intersections
+// only decode the blocks that may hold the integers they look for. The last integers,
+// fewer than 128, are kept uncompressed until their block is full.
+package sortedset
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/cursor"
+	dbp32 "github.com/dataence/encoding/delta/bp32"
+)
+
+const (
+	DefaultBlockSize = 128
+
+	// Size of the working area a block is compressed into, large enough for the
+	// headers and exceptions of any of the delta codecs
+	maxBlockSize = 4 * DefaultBlockSize
+)
+
+// SortedSet is a compressed set of integers: this is not thread-safe, even for
+// reading, as blocks are decoded in a working area (need one per thread)
+type SortedSet struct {
+	newCodec func() encoding.Integer
+	codec    encoding.DeltaInteger
+
+	// Compressed blocks, the position of each of them in data, and their minimum and
+	// maximum integers
+	data   []int32
+	blocks []int
+	mins   []int32
+	maxs   []int32
+
+	// Integers greater than those of the blocks, not compressed yet
+	tail  [DefaultBlockSize]int32
+	ntail int
+
+	// Working area: the last decoded block, whose index is cached
+	buf    [DefaultBlockSize]int32
+	cached int
+	out    [maxBlockSize]int32
+}
+
+// New returns an empty set whose blocks are compressed with delta BP32
+func New() *SortedSet {
+	set, _ := NewWith(dbp32.New)
+	return set
+}
+
+// NewWith returns an empty set whose blocks are compressed with the codecs returned by
+// newCodec, e.g. delta/fastpfor.New, which must be delta codecs
+func NewWith(newCodec func() encoding.Integer) (*SortedSet, error) {
+	codec, ok := newCodec().(encoding.DeltaInteger)
+	if !ok {
+		return nil, errors.New("sortedset/NewWith: codec is not a delta codec")
+	}
+
+	return &SortedSet{
+		newCodec: newCodec,
+		codec:    codec,
+		cached:   -1,
+	}, nil
+}
+
+// Len returns the number of integers in the set
+func (this *SortedSet) Len() int {
+	return len(this.blocks)*DefaultBlockSize + this.ntail
+}
+
+// CompressedSize returns the number of int32s used by the compressed blocks and the
+// tail, not counting the positions, minimums and maximums of the blocks
+func (this *SortedSet) CompressedSize() int {
+	return len(this.data) + this.ntail
+}
+
+// Add adds values to the set. The blocks holding integers greater than the smallest
+// value are rebuilt, so integers are best added in large batches, or in increasing
+// order, which only involves the tail.
+func (this *SortedSet) Add(values ...int32) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	batch := make([]int32, len(values))
+	copy(batch, values)
+	sort.Sort(int32s(batch))
+	batch = unique(batch)
+
+	// First block holding integers greater than or equal to the smallest value
+	b := sort.Search(len(this.blocks), func(i int) bool {
+		return this.maxs[i] >= batch[0]
+	})
+
+	current := make([]int32, 0, (len(this.blocks)-b)*DefaultBlockSize+this.ntail)
+	for k := b; k < len(this.blocks); k++ {
+		block, err := this.decode(k, this.buf[:])
+		if err != nil {
+			return errors.New("sortedset/Add: " + err.Error())
+		}
+		current = append(current, block...)
+	}
+	current = append(current, this.tail[:this.ntail]...)
+
+	if b < len(this.blocks) {
+		this.data = this.data[:this.blocks[b]]
+		this.blocks = this.blocks[:b]
+		this.mins = this.mins[:b]
+		this.maxs = this.maxs[:b]
+	}
+	this.ntail = 0
+	this.cached = -1
+
+	if err := this.appendSorted(merge(current, batch)); err != nil {
+		return errors.New("sortedset/Add: " + err.Error())
+	}
+
+	return nil
+}
+
+// appendSorted adds values, which are sorted, distinct and greater than the integers
+// of the set, compressing the tail whenever it is full
+func (this *SortedSet) appendSorted(values []int32) error {
+	for len(values) > 0 {
+		n := copy(this.tail[this.ntail:], values)
+		this.ntail += n
+		values = values[n:]
+
+		if this.ntail == DefaultBlockSize {
+			if err := this.flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// flush compresses the tail, which is full, as a new block
+func (this *SortedSet) flush() error {
+	min, max := this.tail[0], this.tail[DefaultBlockSize-1]
+
+	inpos, outpos := cursor.New(), cursor.New()
+	if err := this.codec.CompressFrom(min, this.tail[:], inpos, DefaultBlockSize, this.out[:], outpos); err != nil {
+		return err
+	}
+	if inpos.Get() != DefaultBlockSize {
+		return errors.New("codec compressed " + strconv.Itoa(inpos.Get()) + " integers of a block")
+	}
+
+	this.blocks = append(this.blocks, len(this.data))
+	this.data = append(this.data, this.out[:outpos.Get()]...)
+	this.mins = append(this.mins, min)
+	this.maxs = append(this.maxs, max)
+	this.ntail = 0
+
+	return nil
+}
+
+// decode returns the integers of block b, decoded in buf, or the tail if b is the
+// number of blocks
+func (this *SortedSet) decode(b int, buf []int32) ([]int32, error) {
+	if b == len(this.blocks) {
+		return this.tail[:this.ntail], nil
+	}
+
+	end := len(this.data)
+	if b+1 < len(this.blocks) {
+		end = this.blocks[b+1]
+	}
+
+	inpos, outpos := cursor.New(), cursor.New()
+	inpos.Set(this.blocks[b])
+	if err := this.codec.UncompressFrom(this.mins[b], this.data, inpos, end-this.blocks[b], buf[:DefaultBlockSize], outpos); err != nil {
+		return nil, err
+	}
+	if outpos.Get() != DefaultBlockSize {
+		return nil, errors.New("codec decoded " + strconv.Itoa(outpos.Get()) + " integers of a block")
+	}
+
+	return buf[:DefaultBlockSize], nil
+}
+
+// block returns the integers of block b, decoding it in the working area unless it
+// is the last one decoded. As the set itself produced the blocks, failing to decode
+// one means it was corrupted, so it panics.
+func (this *SortedSet) block(b int) []int32 {
+	if b == len(this.blocks) {
+		return this.tail[:this.ntail]
+	}
+
+	if this.cached != b {
+		if _, err := this.decode(b, this.buf[:]); err != nil {
+			panic("sortedset: block " + strconv.Itoa(b) + " is corrupted: " + err.Error())
+		}
+		this.cached = b
+	}
+
+	return this.buf[:]
+}
+
+// find returns the index of the block that may hold v, i.e. the first block whose
+// maximum is greater than or equal to v, or the number of blocks for the tail
+func (this *SortedSet) find(v int32) int {
+	return sort.Search(len(this.blocks), func(i int) bool {
+		return this.maxs[i] >= v
+	})
+}
+
+// Contains reports whether v is in the set
+func (this *SortedSet) Contains(v int32) bool {
+	b := this.find(v)
+	if b < len(this.blocks) && this.mins[b] > v {
+		return false
+	}
+
+	block := this.block(b)
+	i := search(block, v)
+
+	return i < len(block) && block[i] == v
+}
+
+// Rank returns the number of integers of the set that are less than or equal to v
+func (this *SortedSet) Rank(v int32) int {
+	b := this.find(v)
+	if b < len(this.blocks) && this.mins[b] > v {
+		return b * DefaultBlockSize
+	}
+
+	block := this.block(b)
+	i := search(block, v)
+	if i < len(block) && block[i] == v {
+		i++
+	}
+
+	return b*DefaultBlockSize + i
+}
+
+// Select returns the integer of rank i+1, i.e. the i-th smallest one counting from 0,
+// and panics if i is out of range
+func (this *SortedSet) Select(i int) int32 {
+	if i < 0 || i >= this.Len() {
+		panic("sortedset/Select: index " + strconv.Itoa(i) + " out of range [0:" + strconv.Itoa(this.Len()) + "]")
+	}
+
+	return this.block(i / DefaultBlockSize)[i%DefaultBlockSize]
+}
+
+// Range calls f with the rank, counting from 0, and value of each integer, in increasing
+// order, until f returns false
+func (this *SortedSet) Range(f func(i int, v int32) bool) {
+	it := this.iterator()
+	for i := 0; it.valid(); i++ {
+		if !f(i, it.value()) {
+			return
+		}
+		it.next()
+	}
+}
+
+// Union returns a new set, compressed with the same codec as this one, holding the
+// integers that are in this set or in other
+func (this *SortedSet) Union(other *SortedSet) (*SortedSet, error) {
+	result, _ := NewWith(this.newCodec)
+
+	values := make([]int32, 0, DefaultBlockSize)
+	a, b := this.iterator(), other.iterator()
+	for a.valid() || b.valid() {
+		switch {
+		case !b.valid() || a.valid() && a.value() < b.value():
+			values = append(values, a.value())
+			a.next()
+		case !a.valid() || b.value() < a.value():
+			values = append(values, b.value())
+			b.next()
+		default:
+			values = append(values, a.value())
+			a.next()
+			b.next()
+		}
+
+		if len(values) == cap(values) {
+			if err := result.appendSorted(values); err != nil {
+				return nil, errors.New("sortedset/Union: " + err.Error())
+			}
+			values = values[:0]
+		}
+	}
+
+	if err := result.appendSorted(values); err != nil {
+		return nil, errors.New("sortedset/Union: " + err.Error())
+	}
+
+	return result, nil
+}
+
+// Intersect returns a new set, compressed with the same codec as this one, holding the
+// integers that are both in this set and in other. The blocks of either set whose
+// integers are all smaller than the next integer of the other set are not decoded.
+func (this *SortedSet) Intersect(other *SortedSet) (*SortedSet, error) {
+	result, _ := NewWith(this.newCodec)
+
+	values := make([]int32, 0, DefaultBlockSize)
+	a, b := this.iterator(), other.iterator()
+	for a.valid() && b.valid() {
+		switch x, y := a.value(), b.value(); {
+		case x < y:
+			a.advance(y)
+		case y < x:
+			b.advance(x)
+		default:
+			values = append(values, x)
+			a.next()
+			b.next()
+		}
+
+		if len(values) == cap(values) {
+			if err := result.appendSorted(values); err != nil {
+				return nil, errors.New("sortedset/Intersect: " + err.Error())
+			}
+			values = values[:0]
+		}
+	}
+
+	if err := result.appendSorted(values); err != nil {
+		return nil, errors.New("sortedset/Intersect: " + err.Error())
+	}
+
+	return result, nil
+}
+
+// iterator goes through the integers of a set in increasing order, decoding the blocks
+// in its own buffer, so that several iterators can go through the same set
+type iterator struct {
+	set    *SortedSet
+	b      int
+	values []int32
+	i      int
+	buf    [DefaultBlockSize]int32
+}
+
+func (this *SortedSet) iterator() *iterator {
+	it := &iterator{set: this}
+	it.load(0)
+	return it
+}
+
+// load moves to the first integer of block b
+func (this *iterator) load(b int) {
+	values, err := this.set.decode(b, this.buf[:])
+	if err != nil {
+		panic("sortedset: block " + strconv.Itoa(b) + " is corrupted: " + err.Error())
+	}
+
+	this.b, this.values, this.i = b, values, 0
+}
+
+func (this *iterator) valid() bool {
+	return this.i < len(this.values)
+}
+
+func (this *iterator) value() int32 {
+	return this.values[this.i]
+}
+
+func (this *iterator) next() {
+	this.i++
+	if this.i == len(this.values) && this.b < len(this.set.blocks) {
+		this.load(this.b + 1)
+	}
+}
+
+// advance moves to the first integer greater than or equal to v, skipping the blocks
+// whose maximum is less than v
+func (this *iterator) advance(v int32) {
+	if this.b < len(this.set.blocks) && this.set.maxs[this.b] < v {
+		this.load(this.b + 1 + sort.Search(len(this.set.blocks)-this.b-1, func(i int) bool {
+			return this.set.maxs[this.b+1+i] >= v
+		}))
+	}
+
+	this.i += search(this.values[this.i:], v)
+	if this.i == len(this.values) && this.b < len(this.set.blocks) {
+		this.load(this.b + 1)
+	}
+}
+
+// search returns the index of the first integer of values, which are sorted, that is
+// greater than or equal to v
+func search(values []int32, v int32) int {
+	return sort.Search(len(values), func(i int) bool {
+		return values[i] >= v
+	})
+}
+
+// merge returns the distinct integers of a and b, which are sorted and distinct
+func merge(a, b []int32) []int32 {
+	out := make([]int32, 0, len(a)+len(b))
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			out = append(out, a[i])
+			i++
+		case b[j] < a[i]:
+			out = append(out, b[j])
+			j++
+		default:
+			out = append(out, a[i])
+			i++
+			j++
+		}
+	}
+	out = append(out, a[i:]...)
+	out = append(out, b[j:]...)
+
+	return out
+}
+
+// unique removes the duplicates of values, which are sorted
+func unique(values []int32) []int32 {
+	n := 1
+	for _, v := range values[1:] {
+		if v != values[n-1] {
+			values[n] = v
+			n++
+		}
+	}
+
+	return values[:n]
+}
+
+type int32s []int32
+
+func (this int32s) Len() int           { return len(this) }
+func (this int32s) Less(i, j int) bool { return this[i] < this[j] }
+func (this int32s) Swap(i, j int)      { this[i], this[j] = this[j], this[i] }
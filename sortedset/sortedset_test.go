@@ -0,0 +1,273 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package sortedset
+
+import (
+	"log"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	dfastpfor "github.com/dataence/encoding/delta/fastpfor"
+	"github.com/dataence/encoding/generators"
+	"github.com/dataence/encoding/variablebyte"
+)
+
+var (
+	data []int32
+	size int = 128000
+)
+
+func init() {
+	log.Printf("sortedset/init: generating %d int32s\n", size)
+	data = unique(generators.GenerateClustered(size, size*20))
+	log.Printf("sortedset/init: generated %d distinct integers for test", len(data))
+}
+
+// codecs returns the constructors of the sets to test
+func codecs() map[string]func() *SortedSet {
+	return map[string]func() *SortedSet{
+		"delta bp32": New,
+		"delta fastpfor": func() *SortedSet {
+			set, err := NewWith(dfastpfor.New)
+			if err != nil {
+				panic(err)
+			}
+			return set
+		},
+	}
+}
+
+// check verifies that set holds exactly the integers of expected, which are sorted
+func check(t *testing.T, name string, set *SortedSet, expected []int32) {
+	if set.Len() != len(expected) {
+		t.Fatalf("sortedset/%s: %d integers, expected %d", name, set.Len(), len(expected))
+	}
+
+	set.Range(func(i int, v int32) bool {
+		if v != expected[i] {
+			t.Fatalf("sortedset/%s: integer %d is %d, expected %d", name, i, v, expected[i])
+		}
+		return true
+	})
+}
+
+// random returns n distinct integers, among which negative ones, and the same integers sorted
+func random(r *rand.Rand, n int) ([]int32, []int32) {
+	seen := make(map[int32]bool)
+	values := make([]int32, 0, n)
+	for len(values) < n {
+		v := r.Int31n(1<<20) - 1<<19
+		if len(values) == 0 {
+			v = math.MinInt32
+		} else if len(values) == 1 {
+			v = math.MaxInt32
+		}
+
+		if !seen[v] {
+			seen[v] = true
+			values = append(values, v)
+		}
+	}
+
+	sorted := make([]int32, n)
+	copy(sorted, values)
+	sort.Sort(int32s(sorted))
+
+	return values, sorted
+}
+
+func TestAdd(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	values, sorted := random(r, 20000)
+
+	for name, newSet := range codecs() {
+		// In increasing order
+		set := newSet()
+		for s := 0; s < len(data); s += 1000 {
+			e := s + 1000
+			if e > len(data) {
+				e = len(data)
+			}
+			if err := set.Add(data[s:e]...); err != nil {
+				t.Fatal(err)
+			}
+		}
+		check(t, name, set, data)
+
+		// In batches of random integers, with duplicates
+		set = newSet()
+		for s := 0; s < len(values); {
+			n := r.Intn(3000)
+			if n > len(values)-s {
+				n = len(values) - s
+			}
+			if err := set.Add(values[s : s+n]...); err != nil {
+				t.Fatal(err)
+			}
+			if err := set.Add(values[s/2 : s/2+n/2]...); err != nil {
+				t.Fatal(err)
+			}
+			s += n
+		}
+		check(t, name, set, sorted)
+	}
+}
+
+func TestNewWith(t *testing.T) {
+	if _, err := NewWith(variablebyte.New); err == nil {
+		t.Fatalf("sortedset/TestNewWith: expected an error for a codec that is not a delta codec")
+	}
+}
+
+func TestContainsRankSelect(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	values, sorted := random(r, 1000)
+
+	for name, newSet := range codecs() {
+		set := newSet()
+		if set.Contains(0) || set.Rank(0) != 0 {
+			t.Fatalf("sortedset/%s: empty set contains 0", name)
+		}
+
+		if err := set.Add(values...); err != nil {
+			t.Fatal(err)
+		}
+
+		for i, v := range sorted {
+			if !set.Contains(v) {
+				t.Fatalf("sortedset/%s: %d not found", name, v)
+			}
+			if rank := set.Rank(v); rank != i+1 {
+				t.Fatalf("sortedset/%s: rank of %d is %d, expected %d", name, v, rank, i+1)
+			}
+			if s := set.Select(i); s != v {
+				t.Fatalf("sortedset/%s: integer of rank %d is %d, expected %d", name, i+1, s, v)
+			}
+
+			if i > 0 && sorted[i-1] < v-1 {
+				if set.Contains(v - 1) {
+					t.Fatalf("sortedset/%s: %d found", name, v-1)
+				}
+				if rank := set.Rank(v - 1); rank != i {
+					t.Fatalf("sortedset/%s: rank of %d is %d, expected %d", name, v-1, rank, i)
+				}
+			}
+		}
+
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("sortedset/%s: no panic for Select(%d)", name, len(sorted))
+				}
+			}()
+			set.Select(len(sorted))
+		}()
+	}
+}
+
+func TestUnionIntersect(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	// Sparse identifiers, and a few dense ranges
+	var a, b []int32
+	for v := int32(-100000); v < 5000000; v++ {
+		inA := r.Intn(10) == 0 || v >= 1000000 && v < 1001000
+		inB := r.Intn(50) == 0 || v >= 1000500 && v < 1002000
+
+		if inA {
+			a = append(a, v)
+		}
+		if inB {
+			b = append(b, v)
+		}
+	}
+
+	var union, intersection []int32
+	for _, v := range merge(a, b) {
+		i, j := search(a, v), search(b, v)
+		inA, inB := i < len(a) && a[i] == v, j < len(b) && b[j] == v
+		if inA && inB {
+			intersection = append(intersection, v)
+		}
+		union = append(union, v)
+	}
+
+	for name, newSet := range codecs() {
+		sa, sb := newSet(), newSet()
+		if err := sa.Add(a...); err != nil {
+			t.Fatal(err)
+		}
+		if err := sb.Add(b...); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, sets := range [][2]*SortedSet{{sa, sb}, {sb, sa}} {
+			u, err := sets[0].Union(sets[1])
+			if err != nil {
+				t.Fatal(err)
+			}
+			check(t, name+" union", u, union)
+
+			i, err := sets[0].Intersect(sets[1])
+			if err != nil {
+				t.Fatal(err)
+			}
+			check(t, name+" intersection", i, intersection)
+		}
+
+		self, err := sa.Intersect(sa)
+		if err != nil {
+			t.Fatal(err)
+		}
+		check(t, name+" self intersection", self, a)
+
+		empty, err := sa.Intersect(newSet())
+		if err != nil {
+			t.Fatal(err)
+		}
+		check(t, name+" empty intersection", empty, nil)
+	}
+}
+
+func TestCompressedSize(t *testing.T) {
+	for name, newSet := range codecs() {
+		set := newSet()
+		if err := set.Add(data...); err != nil {
+			t.Fatal(err)
+		}
+
+		if set.CompressedSize()*2 > len(data) {
+			t.Fatalf("sortedset/%s: %d int32s for %d integers", name, set.CompressedSize(), len(data))
+		}
+	}
+}
+
+func BenchmarkContains(b *testing.B) {
+	b.StopTimer()
+	length := 128 * 1024
+	data := generators.GenerateClustered(length, 1<<24)
+	set := New()
+	set.Add(data...)
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		set.Contains(int32(j * 7919 % (1 << 24)))
+	}
+}
+
+func BenchmarkIntersect(b *testing.B) {
+	b.StopTimer()
+	length := 128 * 1024
+	x, y := New(), New()
+	x.Add(generators.GenerateClustered(length, 1<<24)...)
+	y.Add(generators.GenerateClustered(length/16, 1<<24)...)
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		x.Intersect(y)
+	}
+}
@@ -0,0 +1,365 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package tschunk stores time series of (timestamp, value) points in compressed chunks
+// of a fixed number of points, as done by Gorilla.
+// The timestamps of a chunk are stored as offsets from its first timestamp, compressed
+// with delta-of-delta encoding. The values are compressed with zigzag BP32 if they are
+// all integers, such as counters, and with Gorilla XOR encoding otherwise.
+// The smallest and largest timestamps of every chunk are kept uncompressed, so that a
+// scan only decodes the chunks overlapping the time range it covers. The last points,
+// until there are enough of them to fill a chunk, are kept uncompressed.
+// For details, please see
+// Tuomas Pelkonen et al., Gorilla: A Fast, Scalable, In-Memory Time Series Database,
+// VLDB 2015 http://www.vldb.org/pvldb/vol8/p1816-teller.pdf
+package tschunk
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/composition"
+	"github.com/dataence/encoding/cursor"
+	"github.com/dataence/encoding/deltadelta"
+	"github.com/dataence/encoding/xorfloat"
+	zbp32 "github.com/dataence/encoding/zigzag/bp32"
+	zvb "github.com/dataence/encoding/zigzag/variablebyte"
+)
+
+const (
+	DefaultChunkSize = 1024
+
+	// Encodings of the values of a chunk
+	ValuesFloat   = 0
+	ValuesInteger = 1
+
+	// A chunk starts with its number of points, the encoding of its values and its first
+	// timestamp, as two int32s
+	headerSize = 4
+)
+
+// chunk is a compressed chunk, with the range of its timestamps
+type chunk struct {
+	minTime, maxTime int64
+	data             []int32
+}
+
+// Series is a time series: this is not thread-safe, even for reading, as chunks are
+// decoded in a working area (need one per thread)
+type Series struct {
+	chunkSize int
+	chunks    []chunk
+
+	// Points not compressed yet
+	times  []int64
+	values []float64
+
+	// Codecs of the timestamps, integer values and float values
+	timesCodec  encoding.Integer
+	intsCodec   encoding.Integer
+	floatsCodec encoding.Float64
+
+	// Working area
+	offsets []int32
+	ints    []int32
+	buf     []int32
+	dtimes  []int64
+	dvalues []float64
+}
+
+func New() *Series {
+	return NewWithChunkSize(DefaultChunkSize)
+}
+
+// NewWithChunkSize returns a series whose chunks hold chunkSize points, except those
+// whose timestamps span more than an int32
+func NewWithChunkSize(chunkSize int) *Series {
+	return &Series{
+		chunkSize:   chunkSize,
+		times:       make([]int64, 0, chunkSize),
+		values:      make([]float64, 0, chunkSize),
+		timesCodec:  deltadelta.New(),
+		intsCodec:   composition.New(zbp32.New(), zvb.New()),
+		floatsCodec: xorfloat.NewGorilla64(),
+		offsets:     make([]int32, chunkSize),
+		ints:        make([]int32, chunkSize),
+		buf:         make([]int32, headerSize+8*chunkSize+1024),
+		dtimes:      make([]int64, chunkSize),
+		dvalues:     make([]float64, chunkSize),
+	}
+}
+
+// Len returns the number of points
+func (this *Series) Len() int {
+	n := len(this.times)
+	for _, c := range this.chunks {
+		n += int(c.data[0])
+	}
+
+	return n
+}
+
+// Append adds a point, whose timestamp must not be smaller than the previous one
+func (this *Series) Append(t int64, v float64) error {
+	if n := len(this.times); n > 0 {
+		if t < this.times[n-1] {
+			return errors.New("tschunk/Append: timestamp " + strconv.FormatInt(t, 10) + " smaller than the previous one")
+		}
+
+		// The offsets of the timestamps from the first one must fit in an int32
+		if t-this.times[0] > math.MaxInt32 || t-this.times[0] < 0 {
+			if err := this.flush(); err != nil {
+				return errors.New("tschunk/Append: " + err.Error())
+			}
+		}
+	} else if c := len(this.chunks); c > 0 && t < this.chunks[c-1].maxTime {
+		return errors.New("tschunk/Append: timestamp " + strconv.FormatInt(t, 10) + " smaller than the previous one")
+	}
+
+	this.times = append(this.times, t)
+	this.values = append(this.values, v)
+
+	if len(this.times) == this.chunkSize {
+		if err := this.flush(); err != nil {
+			return errors.New("tschunk/Append: " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+// isInteger reports whether v is stored exactly as an int32
+func isInteger(v float64) bool {
+	return v == float64(int32(v)) && !(v == 0 && math.Signbit(v))
+}
+
+// flush compresses the points not compressed yet as a new chunk
+func (this *Series) flush() error {
+	n := len(this.times)
+	if n == 0 {
+		return nil
+	}
+
+	first := this.times[0]
+	for i, t := range this.times {
+		this.offsets[i] = int32(t - first)
+	}
+
+	kind := ValuesInteger
+	for _, v := range this.values {
+		if !isInteger(v) {
+			kind = ValuesFloat
+			break
+		}
+	}
+
+	this.buf[0] = int32(n)
+	this.buf[1] = int32(kind)
+	this.buf[2] = int32(first >> 32)
+	this.buf[3] = int32(first)
+
+	inpos, outpos := cursor.New(), cursor.New()
+	outpos.Set(headerSize)
+	if err := this.timesCodec.Compress(this.offsets, inpos, n, this.buf, outpos); err != nil {
+		return err
+	}
+
+	inpos = cursor.New()
+	if kind == ValuesInteger {
+		for i, v := range this.values {
+			this.ints[i] = int32(v)
+		}
+		if err := this.intsCodec.Compress(this.ints, inpos, n, this.buf, outpos); err != nil {
+			return err
+		}
+	} else {
+		if err := this.floatsCodec.Compress(this.values, inpos, n, this.buf, outpos); err != nil {
+			return err
+		}
+	}
+
+	data := make([]int32, outpos.Get())
+	copy(data, this.buf)
+	this.chunks = append(this.chunks, chunk{minTime: first, maxTime: this.times[n-1], data: data})
+
+	this.times = this.times[:0]
+	this.values = this.values[:0]
+
+	return nil
+}
+
+// decode returns the points of chunk c, decoded in the working area
+func (this *Series) decode(c int) ([]int64, []float64, error) {
+	data := this.chunks[c].data
+	n := int(data[0])
+	if n < 1 || n > this.chunkSize {
+		return nil, nil, errors.New("invalid number of points " + strconv.Itoa(n))
+	}
+
+	inpos, outpos := cursor.New(), cursor.New()
+	inpos.Set(headerSize)
+	if err := this.timesCodec.Uncompress(data, inpos, len(data)-headerSize, this.offsets, outpos); err != nil {
+		return nil, nil, err
+	}
+
+	first := int64(data[2])<<32 | int64(uint32(data[3]))
+	for i, offset := range this.offsets[:n] {
+		this.dtimes[i] = first + int64(offset)
+	}
+
+	outpos = cursor.New()
+	switch data[1] {
+	case ValuesInteger:
+		if err := this.intsCodec.Uncompress(data, inpos, len(data)-inpos.Get(), this.ints, outpos); err != nil {
+			return nil, nil, err
+		}
+		for i, v := range this.ints[:n] {
+			this.dvalues[i] = float64(v)
+		}
+	case ValuesFloat:
+		if err := this.floatsCodec.Uncompress(data, inpos, len(data)-inpos.Get(), this.dvalues, outpos); err != nil {
+			return nil, nil, err
+		}
+	default:
+		return nil, nil, errors.New("unknown encoding of values " + strconv.Itoa(int(data[1])))
+	}
+
+	if outpos.Get() != n {
+		return nil, nil, errors.New("wrong number of values")
+	}
+
+	return this.dtimes[:n], this.dvalues[:n], nil
+}
+
+// Scan calls f with the points whose timestamps are in [from, to), in order, until f
+// returns false. Only the chunks overlapping the range are decoded.
+func (this *Series) Scan(from, to int64, f func(t int64, v float64) bool) error {
+	first := sort.Search(len(this.chunks), func(c int) bool {
+		return this.chunks[c].maxTime >= from
+	})
+
+	for c := first; c < len(this.chunks) && this.chunks[c].minTime < to; c++ {
+		times, values, err := this.decode(c)
+		if err != nil {
+			return errors.New("tschunk/Scan: chunk " + strconv.Itoa(c) + ": " + err.Error())
+		}
+
+		if !scan(times, values, from, to, f) {
+			return nil
+		}
+	}
+
+	scan(this.times, this.values, from, to, f)
+
+	return nil
+}
+
+// scan calls f with the points whose timestamps are in [from, to), and returns false if
+// f did or if there may be points in the range after these ones
+func scan(times []int64, values []float64, from, to int64, f func(t int64, v float64) bool) bool {
+	i := sort.Search(len(times), func(i int) bool {
+		return times[i] >= from
+	})
+
+	for ; i < len(times); i++ {
+		if times[i] >= to {
+			return false
+		}
+		if !f(times[i], values[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Aggregation combines the values of the points of a time interval into one value
+type Aggregation int
+
+const (
+	Mean Aggregation = iota
+	Sum
+	Min
+	Max
+	Count
+	First
+	Last
+)
+
+// Downsample splits [from, to) into intervals of step, and calls f with the start of
+// each interval holding points and the aggregation of their values, in order, until f
+// returns false
+func (this *Series) Downsample(from, to, step int64, agg Aggregation, f func(t int64, v float64) bool) error {
+	if step <= 0 {
+		return errors.New("tschunk/Downsample: step must be positive")
+	}
+	if agg < Mean || agg > Last {
+		return errors.New("tschunk/Downsample: unknown aggregation " + strconv.Itoa(int(agg)))
+	}
+
+	var start int64
+	var n int
+	var sum, min, max, first, last float64
+
+	emit := func() bool {
+		if n == 0 {
+			return true
+		}
+
+		var v float64
+		switch agg {
+		case Mean:
+			v = sum / float64(n)
+		case Sum:
+			v = sum
+		case Min:
+			v = min
+		case Max:
+			v = max
+		case Count:
+			v = float64(n)
+		case First:
+			v = first
+		case Last:
+			v = last
+		}
+
+		n = 0
+		return f(start, v)
+	}
+
+	stopped := false
+	err := this.Scan(from, to, func(t int64, v float64) bool {
+		if s := from + (t-from)/step*step; n == 0 || s != start {
+			if !emit() {
+				stopped = true
+				return false
+			}
+			start = s
+			sum, min, max, first = 0, v, v, v
+		}
+
+		n++
+		sum += v
+		min = math.Min(min, v)
+		max = math.Max(max, v)
+		last = v
+
+		return true
+	})
+	if err != nil {
+		return errors.New("tschunk/Downsample: " + err.Error())
+	}
+
+	if !stopped {
+		emit()
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package tschunk
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+type point struct {
+	t int64
+	v float64
+}
+
+// readings returns points every 10 seconds, in milliseconds, with some jitter, whose
+// values are integers in the first half and floats in the second one
+func readings(n int) []point {
+	r := rand.New(rand.NewSource(1))
+	points := make([]point, n)
+
+	t, v := int64(1400000000000), 20.0
+	for i := range points {
+		t += 10000 + int64(r.Intn(5))
+		if i < n/2 {
+			points[i] = point{t, float64(r.Intn(100) - 50)}
+		} else {
+			v += r.Float64() - 0.5
+			points[i] = point{t, v}
+		}
+	}
+	points[1] = point{points[1].t, math.Inf(1)}
+	points[2] = point{points[2].t, math.Copysign(0, -1)}
+	points[n-1] = point{points[n-2].t, math.NaN()}
+
+	return points
+}
+
+func series(t *testing.T, points []point, chunkSize int) *Series {
+	s := NewWithChunkSize(chunkSize)
+	for _, p := range points {
+		if err := s.Append(p.t, p.v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return s
+}
+
+func same(a, b float64) bool {
+	return math.Float64bits(a) == math.Float64bits(b)
+}
+
+func TestScan(t *testing.T) {
+	points := readings(10000)
+	s := series(t, points, 1000)
+
+	if s.Len() != len(points) {
+		t.Fatalf("tschunk/TestScan: %d points, expected %d", s.Len(), len(points))
+	}
+
+	kinds := make(map[int32]int)
+	for _, c := range s.chunks {
+		kinds[c.data[1]]++
+	}
+	if kinds[ValuesInteger] == 0 || kinds[ValuesFloat] == 0 {
+		t.Fatalf("tschunk/TestScan: chunks with values of each kind %v", kinds)
+	}
+
+	for _, r := range [][2]int{{0, len(points)}, {0, 1}, {999, 1001}, {2500, 7300}, {9990, len(points)}} {
+		from := points[r[0]].t
+		to := points[len(points)-1].t + 1
+		if r[1] < len(points) {
+			to = points[r[1]].t
+		}
+
+		i := r[0]
+		if err := s.Scan(from, to, func(ts int64, v float64) bool {
+			if ts != points[i].t || !same(v, points[i].v) {
+				t.Fatalf("tschunk/TestScan: point %d is (%d, %g), expected (%d, %g)", i, ts, v, points[i].t, points[i].v)
+			}
+			i++
+			return true
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if i != r[1] {
+			t.Fatalf("tschunk/TestScan: scanned up to %d, expected %d", i, r[1])
+		}
+	}
+
+	// Stopping early
+	n := 0
+	s.Scan(math.MinInt64, math.MaxInt64, func(ts int64, v float64) bool {
+		n++
+		return n < 1500
+	})
+	if n != 1500 {
+		t.Fatalf("tschunk/TestScan: %d points after stopping at 1500", n)
+	}
+}
+
+func TestAppend(t *testing.T) {
+	s := New()
+	if err := s.Append(100, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Append(99, 1); err == nil {
+		t.Fatalf("tschunk/TestAppend: no error for a decreasing timestamp")
+	}
+
+	// Timestamps too far apart for one chunk
+	times := []int64{100, 100, 1 << 40, 1<<40 + 1, math.MaxInt64}
+	for _, ts := range times[1:] {
+		if err := s.Append(ts, 2); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(s.chunks) != 2 {
+		t.Fatalf("tschunk/TestAppend: %d chunks, expected 2", len(s.chunks))
+	}
+	if err := s.Append(1<<40, 1); err == nil {
+		t.Fatalf("tschunk/TestAppend: no error for a timestamp smaller than those of a chunk")
+	}
+
+	i := 0
+	s.Scan(math.MinInt64, math.MaxInt64, func(ts int64, v float64) bool {
+		if ts != times[i] {
+			t.Fatalf("tschunk/TestAppend: timestamp %d is %d, expected %d", i, ts, times[i])
+		}
+		i++
+		return true
+	})
+	if i != len(times)-1 {
+		t.Fatalf("tschunk/TestAppend: %d points, expected %d", i, len(times)-1)
+	}
+}
+
+func TestDownsample(t *testing.T) {
+	points := readings(10000)[:5000]
+	s := series(t, points, 128)
+
+	from, step := points[0].t, int64(60000)
+	to := points[len(points)-1].t
+
+	for _, agg := range []Aggregation{Mean, Sum, Min, Max, Count, First, Last} {
+		var starts []int64
+		var values []float64
+		if err := s.Downsample(from, to, step, agg, func(ts int64, v float64) bool {
+			starts = append(starts, ts)
+			values = append(values, v)
+			return true
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		k := 0
+		for i := 0; i < len(points) && points[i].t < to; {
+			start := from + (points[i].t-from)/step*step
+			j := i
+			sum, min, max := 0.0, math.Inf(1), math.Inf(-1)
+			for ; j < len(points) && points[j].t < start+step && points[j].t < to; j++ {
+				sum += points[j].v
+				min = math.Min(min, points[j].v)
+				max = math.Max(max, points[j].v)
+			}
+
+			expected := map[Aggregation]float64{
+				Mean: sum / float64(j-i), Sum: sum, Min: min, Max: max,
+				Count: float64(j - i), First: points[i].v, Last: points[j-1].v,
+			}[agg]
+			if starts[k] != start || !same(values[k], expected) {
+				t.Fatalf("tschunk/TestDownsample: interval %d of aggregation %d is (%d, %g), expected (%d, %g)", k, agg, starts[k], values[k], start, expected)
+			}
+
+			i = j
+			k++
+		}
+		if k != len(starts) {
+			t.Fatalf("tschunk/TestDownsample: %d intervals, expected %d", len(starts), k)
+		}
+	}
+
+	if err := s.Downsample(from, to, 0, Mean, func(int64, float64) bool { return true }); err == nil {
+		t.Fatalf("tschunk/TestDownsample: no error for a step of 0")
+	}
+}
+
+func TestCompression(t *testing.T) {
+	points := readings(100000)
+	s := series(t, points, DefaultChunkSize)
+
+	size := 0
+	for _, c := range s.chunks {
+		size += len(c.data)
+	}
+
+	// Each point takes 4 int32s uncompressed
+	if size*2 > 4*len(points) {
+		t.Fatalf("tschunk/TestCompression: %d int32s for %d points", size, len(points))
+	}
+}
+
+func BenchmarkScan(b *testing.B) {
+	b.StopTimer()
+	points := readings(128 * 1024)
+	s := New()
+	for _, p := range points {
+		s.Append(p.t, p.v)
+	}
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		s.Scan(math.MinInt64, math.MaxInt64, func(int64, float64) bool { return true })
+	}
+}
@@ -34,6 +34,20 @@ func DeltaMaxBits(initoffset int32, buf []int32) int32 {
 	return LeadingBitPosition(uint32(mask))
 }
 
+// ZigZagDeltaMaxBits is the number of bits needed by the largest zigzag encoded
+// difference between successive integers of buf, the first one being initoffset
+func ZigZagDeltaMaxBits(initoffset int32, buf []int32) int32 {
+	var mask int32
+
+	for _, v := range buf {
+		n := v - initoffset
+		mask |= (n << 1) ^ (n >> 31)
+		initoffset = v
+	}
+
+	return LeadingBitPosition(uint32(mask))
+}
+
 func MaxBits(buf []int32) int32 {
 	var mask int32
 
@@ -65,6 +79,19 @@ func InverseDelta(in, out []int32, offset int32) {
 	}
 }
 
+// https://developers.google.com/protocol-buffers/docs/encoding#types
+func ZigZag(in, out []int32) {
+	for i, v := range in {
+		out[i] = (v << 1) ^ (v >> 31)
+	}
+}
+
+func InverseZigZag(in, out []int32) {
+	for i, v := range in {
+		out[i] = int32(uint32(v)>>1) ^ ((v << 31) >> 31)
+	}
+}
+
 // https://developers.google.com/protocol-buffers/docs/encoding#types
 func ZigZagDelta(in, out []int32) {
 	offset := int32(0)
@@ -64,11 +64,13 @@ func (this *VariableByte) Compress(in []int32, inpos *cursor.Cursor, inlength in
 	return nil
 }
 
-func (this *VariableByte) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+func (this *VariableByte) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) (err error) {
 	if inlength == 0 {
 		return errors.New("VariableByte/Uncompress: inlength = 0. No work done.")
 	}
 
+	defer encoding.Recover("VariableByte/Uncompress", &err)
+
 	//fmt.Printf("VariableByte/Uncompress: after inlength = %d\n", inlength)
 
 	s := uint(0)
@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package xorfloat
+
+import (
+	"math/bits"
+
+	"github.com/dataence/encoding/bitio"
+)
+
+const (
+	// XORs with more trailing zeros than this have their center bits stored
+	chimpTrailingThreshold = 6
+)
+
+var (
+	// Number of leading zeros for each of the 3-bit representations
+	chimpLeadingValues = [8]uint{0, 8, 12, 16, 18, 20, 22, 24}
+
+	// 3-bit representation of each possible number of leading zeros, rounded down
+	chimpLeadingRepresentation [65]uint32
+)
+
+func init() {
+	for lz := range chimpLeadingRepresentation {
+		for k, v := range chimpLeadingValues {
+			if uint(lz) >= v {
+				chimpLeadingRepresentation[lz] = uint32(k)
+			}
+		}
+	}
+}
+
+// chimp encodes each value as follows, where XOR is the value XORed with the previous one
+//   - the first value is stored as is
+//   - '00' if XOR is 0
+//   - '01' followed by the number of leading zeros (3 bits), the number of center bits
+//     (6 bits for float64s, 5 for float32s), and the center bits of XOR, if XOR has
+//     more than 6 trailing zeros
+//   - '10' followed by the bits of XOR after its leading zeros, if it has as many
+//     leading zeros as the previous XOR
+//   - '11' followed by the number of leading zeros (3 bits) and the bits of XOR after
+//     its leading zeros
+type chimp struct {
+	width   uint
+	first   bool
+	prev    uint64
+	leading uint
+}
+
+func newChimp(width uint) *chimp {
+	return &chimp{
+		width:   width,
+		first:   true,
+		leading: width + 1,
+	}
+}
+
+func (this *chimp) centerbits() uint {
+	if this.width == 64 {
+		return 6
+	}
+	return 5
+}
+
+func (this *chimp) encode(w *bitio.Writer, v uint64) {
+	if this.first {
+		w.Write64(v, this.width)
+		this.prev = v
+		this.first = false
+		return
+	}
+
+	xor := v ^ this.prev
+	this.prev = v
+
+	if xor == 0 {
+		w.Write(0, 2)
+		this.leading = this.width + 1
+		return
+	}
+
+	repr := chimpLeadingRepresentation[uint(bits.LeadingZeros64(xor))-(64-this.width)]
+	leading := chimpLeadingValues[repr]
+	trailing := uint(bits.TrailingZeros64(xor))
+
+	switch {
+	case trailing > chimpTrailingThreshold:
+		center := this.width - leading - trailing
+		w.Write(1, 2)
+		w.Write(repr, 3)
+		w.Write(uint32(center), this.centerbits())
+		w.Write64(xor>>trailing, center)
+		this.leading = this.width + 1
+
+	case leading == this.leading:
+		w.Write(2, 2)
+		w.Write64(xor, this.width-leading)
+
+	default:
+		w.Write(3, 2)
+		w.Write(repr, 3)
+		w.Write64(xor, this.width-leading)
+		this.leading = leading
+	}
+}
+
+func (this *chimp) decode(r *bitio.Reader) uint64 {
+	if this.first {
+		this.prev = r.Read64(this.width)
+		this.first = false
+		return this.prev
+	}
+
+	switch r.Read(2) {
+	case 0:
+		this.leading = this.width + 1
+
+	case 1:
+		leading := chimpLeadingValues[r.Read(3)]
+		center := uint(r.Read(this.centerbits()))
+		trailing := this.width - leading - center
+		this.prev ^= r.Read64(center) << trailing
+		this.leading = this.width + 1
+
+	case 2:
+		this.prev ^= r.Read64(this.width - this.leading)
+
+	default:
+		this.leading = chimpLeadingValues[r.Read(3)]
+		this.prev ^= r.Read64(this.width - this.leading)
+	}
+
+	return this.prev
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package xorfloat
+
+import (
+	"math/bits"
+
+	"github.com/dataence/encoding/bitio"
+)
+
+// gorilla encodes each value as follows, where XOR is the value XORed with the previous one
+//   - the first value is stored as is
+//   - '0' if XOR is 0
+//   - '10' followed by the meaningful bits of XOR, if they fit within the previous window
+//   - '11' followed by the number of leading zeros (5 bits), the number of meaningful
+//     bits (6 bits for float64s, 5 for float32s), and the meaningful bits of XOR
+type gorilla struct {
+	width    uint
+	first    bool
+	window   bool
+	prev     uint64
+	leading  uint
+	trailing uint
+}
+
+func newGorilla(width uint) *gorilla {
+	return &gorilla{
+		width: width,
+		first: true,
+	}
+}
+
+func (this *gorilla) sigbits() uint {
+	if this.width == 64 {
+		return 6
+	}
+	return 5
+}
+
+func (this *gorilla) encode(w *bitio.Writer, v uint64) {
+	if this.first {
+		w.Write64(v, this.width)
+		this.prev = v
+		this.first = false
+		return
+	}
+
+	xor := v ^ this.prev
+	this.prev = v
+
+	if xor == 0 {
+		w.Write(0, 1)
+		return
+	}
+
+	leading := uint(bits.LeadingZeros64(xor)) - (64 - this.width)
+	trailing := uint(bits.TrailingZeros64(xor))
+
+	// The number of leading zeros is stored using 5 bits
+	if leading > 31 {
+		leading = 31
+	}
+
+	if this.window && leading >= this.leading && trailing >= this.trailing {
+		w.Write(1, 2)
+		w.Write64(xor>>this.trailing, this.width-this.leading-this.trailing)
+		return
+	}
+
+	// A window as wide as the value itself is stored as 0
+	meaningful := this.width - leading - trailing
+	w.Write(3, 2)
+	w.Write(uint32(leading), 5)
+	w.Write(uint32(meaningful), this.sigbits())
+	w.Write64(xor>>trailing, meaningful)
+
+	this.leading = leading
+	this.trailing = trailing
+	this.window = true
+}
+
+func (this *gorilla) decode(r *bitio.Reader) uint64 {
+	if this.first {
+		this.prev = r.Read64(this.width)
+		this.first = false
+		return this.prev
+	}
+
+	if r.Read(1) == 0 {
+		return this.prev
+	}
+
+	if r.Read(1) == 0 {
+		this.prev ^= r.Read64(this.width-this.leading-this.trailing) << this.trailing
+		return this.prev
+	}
+
+	this.leading = uint(r.Read(5))
+	meaningful := uint(r.Read(this.sigbits()))
+	if meaningful == 0 {
+		meaningful = this.width
+	}
+	this.trailing = this.width - this.leading - meaningful
+
+	this.prev ^= r.Read64(meaningful) << this.trailing
+	return this.prev
+}
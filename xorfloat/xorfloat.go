@@ -0,0 +1,206 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package xorfloat is an implementation of the XOR based floating point compression
+// algorithms Gorilla and Chimp in Go, for both float64s and float32s.
+// Each value is XORed with the previous one, and only the meaningful bits of the
+// result are stored. It is mostly suitable for slowly changing series, such as
+// sensor readings or coordinates.
+// For details, please see
+// Tuomas Pelkonen et al., Gorilla: A Fast, Scalable, In-Memory Time Series Database,
+// VLDB 2015 http://www.vldb.org/pvldb/vol8/p1816-teller.pdf and
+// Panagiotis Liakos, Katia Papakonstantinopoulou and Yannis Kotidis, Chimp: Efficient
+// Lossless Floating Point Compression for Time Series Databases, VLDB 2022
+// https://www.vldb.org/pvldb/vol15/p3058-liakos.pdf
+package xorfloat
+
+import (
+	"errors"
+	"math"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/bitio"
+	"github.com/dataence/encoding/cursor"
+)
+
+// encoder compresses a series of values, each given as the bits of a float64 or a float32
+type encoder interface {
+	encode(w *bitio.Writer, v uint64)
+}
+
+// decoder uncompresses the values written by the corresponding encoder
+type decoder interface {
+	decode(r *bitio.Reader) uint64
+}
+
+type Gorilla64 struct {
+}
+
+var _ encoding.Float64 = (*Gorilla64)(nil)
+
+func NewGorilla64() encoding.Float64 {
+	return &Gorilla64{}
+}
+
+func (this *Gorilla64) Compress(in []float64, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("xorfloat/Gorilla64/Compress: inlength = 0. No work done.")
+	}
+
+	return compress64(newGorilla(64), in, inpos, inlength, out, outpos)
+}
+
+func (this *Gorilla64) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []float64, outpos *cursor.Cursor) (err error) {
+	if inlength == 0 {
+		return errors.New("xorfloat/Gorilla64/Uncompress: inlength = 0. No work done.")
+	}
+
+	defer encoding.Recover("xorfloat/Gorilla64/Uncompress", &err)
+
+	return uncompress64(newGorilla(64), in, inpos, out, outpos)
+}
+
+type Chimp64 struct {
+}
+
+var _ encoding.Float64 = (*Chimp64)(nil)
+
+func NewChimp64() encoding.Float64 {
+	return &Chimp64{}
+}
+
+func (this *Chimp64) Compress(in []float64, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("xorfloat/Chimp64/Compress: inlength = 0. No work done.")
+	}
+
+	return compress64(newChimp(64), in, inpos, inlength, out, outpos)
+}
+
+func (this *Chimp64) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []float64, outpos *cursor.Cursor) (err error) {
+	if inlength == 0 {
+		return errors.New("xorfloat/Chimp64/Uncompress: inlength = 0. No work done.")
+	}
+
+	defer encoding.Recover("xorfloat/Chimp64/Uncompress", &err)
+
+	return uncompress64(newChimp(64), in, inpos, out, outpos)
+}
+
+type Gorilla32 struct {
+}
+
+var _ encoding.Float32 = (*Gorilla32)(nil)
+
+func NewGorilla32() encoding.Float32 {
+	return &Gorilla32{}
+}
+
+func (this *Gorilla32) Compress(in []float32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("xorfloat/Gorilla32/Compress: inlength = 0. No work done.")
+	}
+
+	return compress32(newGorilla(32), in, inpos, inlength, out, outpos)
+}
+
+func (this *Gorilla32) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []float32, outpos *cursor.Cursor) (err error) {
+	if inlength == 0 {
+		return errors.New("xorfloat/Gorilla32/Uncompress: inlength = 0. No work done.")
+	}
+
+	defer encoding.Recover("xorfloat/Gorilla32/Uncompress", &err)
+
+	return uncompress32(newGorilla(32), in, inpos, out, outpos)
+}
+
+type Chimp32 struct {
+}
+
+var _ encoding.Float32 = (*Chimp32)(nil)
+
+func NewChimp32() encoding.Float32 {
+	return &Chimp32{}
+}
+
+func (this *Chimp32) Compress(in []float32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("xorfloat/Chimp32/Compress: inlength = 0. No work done.")
+	}
+
+	return compress32(newChimp(32), in, inpos, inlength, out, outpos)
+}
+
+func (this *Chimp32) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []float32, outpos *cursor.Cursor) (err error) {
+	if inlength == 0 {
+		return errors.New("xorfloat/Chimp32/Uncompress: inlength = 0. No work done.")
+	}
+
+	defer encoding.Recover("xorfloat/Chimp32/Uncompress", &err)
+
+	return uncompress32(newChimp(32), in, inpos, out, outpos)
+}
+
+// The compressed data starts with the number of values, followed by the bit stream
+func compress64(enc encoder, in []float64, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	out[outpos.Get()] = int32(inlength)
+
+	w := bitio.NewWriter(out, outpos.Get()+1)
+	for _, v := range in[inpos.Get() : inpos.Get()+inlength] {
+		enc.encode(w, math.Float64bits(v))
+	}
+	w.Flush()
+
+	inpos.Add(inlength)
+	outpos.Set(w.Pos())
+
+	return nil
+}
+
+func uncompress64(dec decoder, in []int32, inpos *cursor.Cursor, out []float64, outpos *cursor.Cursor) error {
+	outlength := int(in[inpos.Get()])
+
+	r := bitio.NewReader(in, inpos.Get()+1)
+	tmpoutpos := outpos.Get()
+	for i := 0; i < outlength; i++ {
+		out[tmpoutpos+i] = math.Float64frombits(dec.decode(r))
+	}
+
+	inpos.Set(r.Pos())
+	outpos.Add(outlength)
+
+	return nil
+}
+
+func compress32(enc encoder, in []float32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	out[outpos.Get()] = int32(inlength)
+
+	w := bitio.NewWriter(out, outpos.Get()+1)
+	for _, v := range in[inpos.Get() : inpos.Get()+inlength] {
+		enc.encode(w, uint64(math.Float32bits(v)))
+	}
+	w.Flush()
+
+	inpos.Add(inlength)
+	outpos.Set(w.Pos())
+
+	return nil
+}
+
+func uncompress32(dec decoder, in []int32, inpos *cursor.Cursor, out []float32, outpos *cursor.Cursor) error {
+	outlength := int(in[inpos.Get()])
+
+	r := bitio.NewReader(in, inpos.Get()+1)
+	tmpoutpos := outpos.Get()
+	for i := 0; i < outlength; i++ {
+		out[tmpoutpos+i] = math.Float32frombits(uint32(dec.decode(r)))
+	}
+
+	inpos.Set(r.Pos())
+	outpos.Add(outlength)
+
+	return nil
+}
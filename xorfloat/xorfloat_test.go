@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package xorfloat
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/cursor"
+)
+
+var (
+	data []float64
+	size int = 128000
+)
+
+func init() {
+	// A slowly moving coordinate, stored with 5 decimal digits, with a few special values
+	r := rand.New(rand.NewSource(1))
+	data = make([]float64, size)
+	lat := 37.77493
+	for i := range data {
+		lat += float64(r.Intn(11)-5) / 100000
+		data[i] = math.Round(lat*100000) / 100000
+	}
+
+	data[100] = math.NaN()
+	data[101] = math.Inf(1)
+	data[102] = math.Inf(-1)
+	data[103] = math.Copysign(0, -1)
+	data[104] = math.SmallestNonzeroFloat64
+	data[105] = math.MaxFloat64
+}
+
+func testFloat64(t *testing.T, codec encoding.Float64, in []float64) int {
+	out := make([]int32, len(in)*3+1)
+	outpos := cursor.New()
+	if err := codec.Compress(in, cursor.New(), len(in), out, outpos); err != nil {
+		t.Fatal(err)
+	}
+
+	recov := make([]float64, len(in))
+	inpos := cursor.New()
+	recovpos := cursor.New()
+	if err := codec.Uncompress(out, inpos, outpos.Get(), recov, recovpos); err != nil {
+		t.Fatal(err)
+	}
+
+	if inpos.Get() != outpos.Get() || recovpos.Get() != len(in) {
+		t.Fatalf("xorfloat/testFloat64: read %d int32s and wrote %d float64s, expected %d and %d", inpos.Get(), recovpos.Get(), outpos.Get(), len(in))
+	}
+
+	for i := range in {
+		if math.Float64bits(in[i]) != math.Float64bits(recov[i]) {
+			t.Fatalf("xorfloat/testFloat64: Problem recovering. index = %d, in = %v, recovered = %v", i, in[i], recov[i])
+		}
+	}
+
+	return outpos.Get()
+}
+
+func testFloat32(t *testing.T, codec encoding.Float32, in []float32) int {
+	out := make([]int32, len(in)*2+1)
+	outpos := cursor.New()
+	if err := codec.Compress(in, cursor.New(), len(in), out, outpos); err != nil {
+		t.Fatal(err)
+	}
+
+	recov := make([]float32, len(in))
+	inpos := cursor.New()
+	recovpos := cursor.New()
+	if err := codec.Uncompress(out, inpos, outpos.Get(), recov, recovpos); err != nil {
+		t.Fatal(err)
+	}
+
+	if inpos.Get() != outpos.Get() || recovpos.Get() != len(in) {
+		t.Fatalf("xorfloat/testFloat32: read %d int32s and wrote %d float32s, expected %d and %d", inpos.Get(), recovpos.Get(), outpos.Get(), len(in))
+	}
+
+	for i := range in {
+		if math.Float32bits(in[i]) != math.Float32bits(recov[i]) {
+			t.Fatalf("xorfloat/testFloat32: Problem recovering. index = %d, in = %v, recovered = %v", i, in[i], recov[i])
+		}
+	}
+
+	return outpos.Get()
+}
+
+func TestFloat64(t *testing.T) {
+	for _, codec := range []encoding.Float64{NewGorilla64(), NewChimp64()} {
+		for _, k := range []int{1, 2, 3, 1000, size} {
+			testFloat64(t, codec, data[:k])
+		}
+	}
+}
+
+func TestFloat32(t *testing.T) {
+	in := make([]float32, len(data))
+	for i, v := range data {
+		in[i] = float32(v)
+	}
+
+	for _, codec := range []encoding.Float32{NewGorilla32(), NewChimp32()} {
+		for _, k := range []int{1, 2, 3, 1000, size} {
+			testFloat32(t, codec, in[:k])
+		}
+	}
+}
+
+func TestConstant(t *testing.T) {
+	in := make([]float64, 10000)
+	for i := range in {
+		in[i] = 98.6
+	}
+
+	for _, codec := range []encoding.Float64{NewGorilla64(), NewChimp64()} {
+		if n := testFloat64(t, codec, in); n*32 > 2*len(in)+96 {
+			t.Fatalf("xorfloat/TestConstant: compressed %d float64s to %d int32s", len(in), n)
+		}
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	out := make([]int32, 10)
+	if err := NewGorilla64().Compress(nil, cursor.New(), 0, out, cursor.New()); err == nil {
+		t.Fatal("xorfloat/TestEmpty: expected error for empty input")
+	}
+}
+
+// go test -bench=Decode
+func BenchmarkDecode(b *testing.B) {
+	b.StopTimer()
+	compdata := make([]int32, 3*len(data))
+	recov := make([]float64, len(data))
+	inpos := cursor.New()
+	outpos := cursor.New()
+	codec := NewChimp64()
+	codec.Compress(data, inpos, len(data), compdata, outpos)
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		newinpos := cursor.New()
+		newoutpos := cursor.New()
+		codec.Uncompress(compdata, newinpos, outpos.Get()-newinpos.Get(), recov, newoutpos)
+	}
+}
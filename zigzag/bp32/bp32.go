@@ -45,46 +45,29 @@ func (this *BP32) Compress(in []int32, inpos *cursor.Cursor, inlength int, out [
 	tmpoutpos := outpos.Get()
 	s := inpos.Get()
 	finalinpos := s + inlength
-	delta := make([]int32, DefaultBlockSize)
 
+	// Every block starts from 0, and every group of 32 integers from the last
+	// integer of the previous group
 	for ; s < finalinpos; s += DefaultBlockSize {
-		encoding.ZigZagDelta(in[s:s+DefaultBlockSize], delta)
-		//log.Printf("zigzag_bp32/Compress: in = %v\n", in[s:s+DefaultBlockSize])
-		//log.Printf("zigzag_bp32/Compress: delta = %v\n", delta)
-
-		mbits1 := encoding.MaxBits(delta[0:32])
-		mbits2 := encoding.MaxBits(delta[32:64])
-		mbits3 := encoding.MaxBits(delta[64:96])
-		mbits4 := encoding.MaxBits(delta[96:128])
-
-		//log.Printf("zigzag_bp32/Compress: tmpoutpos = %d, s = %d\n", tmpoutpos, s)
+		mbits1 := encoding.ZigZagDeltaMaxBits(0, in[s:s+32])
+		mbits2 := encoding.ZigZagDeltaMaxBits(in[s+31], in[s+32:s+2*32])
+		mbits3 := encoding.ZigZagDeltaMaxBits(in[s+2*32-1], in[s+2*32:s+3*32])
+		mbits4 := encoding.ZigZagDeltaMaxBits(in[s+3*32-1], in[s+3*32:s+4*32])
 
 		out[tmpoutpos] = (mbits1 << 24) | (mbits2 << 16) | (mbits3 << 8) | mbits4
 		tmpoutpos += 1
 
-		//log.Printf("zigzag_bp32/Compress: mbits1 = %d, mbits2 = %d, mbits3 = %d, mbits4 = %d, s = %d\n", mbits1, mbits2, mbits3, mbits4, out[tmpoutpos-1])
-
-		bitpacking.FastPackWithoutMask(delta, 0, out, tmpoutpos, int(mbits1))
-		//encoding.PrintUint32sInBits(in[s:s+32])
-		//encoding.PrintUint32sInBits(out[tmpoutpos:tmpoutpos+int(mbits1]))
+		bitpacking.ZigZagDeltaPack(0, in, s, out, tmpoutpos, int(mbits1))
 		tmpoutpos += int(mbits1)
 
-		bitpacking.FastPackWithoutMask(delta, 32, out, tmpoutpos, int(mbits2))
-		//encoding.PrintUint32sInBits(in, s+32, 32)
-		//encoding.PrintUint32sInBits(out, tmpoutpos, int(mbits2))
+		bitpacking.ZigZagDeltaPack(in[s+31], in, s+32, out, tmpoutpos, int(mbits2))
 		tmpoutpos += int(mbits2)
 
-		bitpacking.FastPackWithoutMask(delta, 64, out, tmpoutpos, int(mbits3))
-		//encoding.PrintUint32sInBits(in, s+2*32, 32)
-		//encoding.PrintUint32sInBits(out, tmpoutpos, int(mbits3))
+		bitpacking.ZigZagDeltaPack(in[s+2*32-1], in, s+2*32, out, tmpoutpos, int(mbits3))
 		tmpoutpos += int(mbits3)
 
-		bitpacking.FastPackWithoutMask(delta, 96, out, tmpoutpos, int(mbits4))
-		//encoding.PrintUint32sInBits(in, s+3*32, 32)
-		//encoding.PrintUint32sInBits(out, tmpoutpos, int(mbits4))
+		bitpacking.ZigZagDeltaPack(in[s+3*32-1], in, s+3*32, out, tmpoutpos, int(mbits4))
 		tmpoutpos += int(mbits4)
-
-		//log.Printf("zigzag_bp32/Compress: out = %v\n", out[s:s+DefaultBlockSize])
 	}
 
 	inpos.Add(inlength)
@@ -93,50 +76,43 @@ func (this *BP32) Compress(in []int32, inpos *cursor.Cursor, inlength int, out [
 	return nil
 }
 
-func (this *BP32) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+func (this *BP32) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) (err error) {
 	if inlength == 0 {
 		return errors.New("zigzag_bp32/Uncompress: Length is 0. No work done.")
 	}
 
+	defer encoding.Recover("zigzag_bp32/Uncompress", &err)
+
 	outlength := int(in[inpos.Get()])
+	if outlength < 0 || outlength > len(out)-outpos.Get() {
+		return errors.New("zigzag_bp32/Uncompress: invalid length. Data may be corrupted.")
+	}
 	inpos.Increment()
 
 	tmpinpos := inpos.Get()
 	s := outpos.Get()
-	finalinpos := s + outlength
-	delta := make([]int32, DefaultBlockSize)
+	finaloutpos := s + outlength
 
-	//log.Printf("zigzag_bp32/Uncompress: outlength = %d, inpos = %d, outpos = %d\n", outlength, inpos.Get(), outpos.Get())
-	for ; s < finalinpos; s += DefaultBlockSize {
+	for ; s < finaloutpos; s += DefaultBlockSize {
 		tmp := in[tmpinpos]
 		mbits1 := tmp >> 24
 		mbits2 := (tmp >> 16) & 0xFF
 		mbits3 := (tmp >> 8) & 0xFF
 		mbits4 := (tmp) & 0xFF
 
-		//log.Printf("zigzag_bp32/Uncopmress: mbits1 = %d, mbits2 = %d, mbits3 = %d, mbits4 = %d, s = %d\n", mbits1, mbits2, mbits3, mbits4, s)
 		tmpinpos += 1
 
-		bitpacking.FastUnpack(in, tmpinpos, delta, 0, int(mbits1))
+		bitpacking.ZigZagDeltaUnpack(0, in, tmpinpos, out, s, int(mbits1))
 		tmpinpos += int(mbits1)
-		//log.Printf("zigzag_bp32/Uncompress: delta = %v\n", out)
 
-		bitpacking.FastUnpack(in, tmpinpos, delta, 32, int(mbits2))
+		bitpacking.ZigZagDeltaUnpack(out[s+31], in, tmpinpos, out, s+32, int(mbits2))
 		tmpinpos += int(mbits2)
-		//log.Printf("zigzag_bp32/Uncompress: delta = %v\n", out)
 
-		bitpacking.FastUnpack(in, tmpinpos, delta, 64, int(mbits3))
+		bitpacking.ZigZagDeltaUnpack(out[s+2*32-1], in, tmpinpos, out, s+2*32, int(mbits3))
 		tmpinpos += int(mbits3)
-		//log.Printf("zigzag_bp32/Uncompress: delta = %v\n", out)
 
-		bitpacking.FastUnpack(in, tmpinpos, delta, 96, int(mbits4))
+		bitpacking.ZigZagDeltaUnpack(out[s+3*32-1], in, tmpinpos, out, s+3*32, int(mbits4))
 		tmpinpos += int(mbits4)
-
-		encoding.InverseZigZagDelta(delta, out[s:s+DefaultBlockSize])
-
-		//log.Printf("zigzag_bp32/Uncompress: delta = %v\n", delta)
-		//log.Printf("zigzag_bp32/Uncompress: out = %v\n", out[s:s+DefaultBlockSize])
-
 	}
 
 	outpos.Add(outlength)
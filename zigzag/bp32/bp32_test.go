@@ -8,9 +8,13 @@ package bp32
 
 import (
 	"log"
+	"math/rand"
 	"testing"
 
+	"github.com/dataence/encoding"
 	"github.com/dataence/encoding/benchtools"
+	"github.com/dataence/encoding/bitpacking"
+	"github.com/dataence/encoding/cursor"
 	"github.com/dataence/encoding/generators"
 )
 
@@ -29,3 +33,55 @@ func TestCodec(t *testing.T) {
 	sizes := []int{128, 128 * 10, 128 * 100, 128 * 1000, 128 * 10000}
 	benchtools.TestCodec(New(), data, sizes)
 }
+
+// TestFormat checks that the fused zigzag delta packing writes the same blocks as
+// zigzag encoding the differences of each block, then packing them.
+func TestFormat(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	in := make([]int32, 128*50)
+	for i := range in {
+		// Small differences in both directions, with a few large ones
+		in[i] = int32(r.Intn(2001) - 1000)
+		if i > 0 {
+			in[i] += in[i-1]
+		}
+		if r.Intn(100) == 0 {
+			in[i] = int32(r.Uint32())
+		}
+	}
+
+	out := make([]int32, 2*len(in))
+	outpos := cursor.New()
+	if err := New().Compress(in, cursor.New(), len(in), out, outpos); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []int32{int32(len(in))}
+	delta := make([]int32, DefaultBlockSize)
+	for s := 0; s < len(in); s += DefaultBlockSize {
+		encoding.ZigZagDelta(in[s:s+DefaultBlockSize], delta)
+
+		var header int32
+		var bits [4]int32
+		for k := range bits {
+			bits[k] = encoding.MaxBits(delta[32*k : 32*(k+1)])
+			header = header<<8 | bits[k]
+		}
+		expected = append(expected, header)
+
+		for k, b := range bits {
+			packed := make([]int32, b)
+			bitpacking.FastPackWithoutMask(delta, 32*k, packed, 0, int(b))
+			expected = append(expected, packed...)
+		}
+	}
+
+	if outpos.Get() != len(expected) {
+		t.Fatalf("compressed to %d int32s, expected %d", outpos.Get(), len(expected))
+	}
+	for i, v := range expected {
+		if out[i] != v {
+			t.Fatalf("int32 %d is %d, expected %d", i, out[i], v)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package nodelta zigzag encodes each integer on its own, without computing the
+// differences between successive integers, before compressing it with another codec.
+// It is mostly suitable for unsorted signed integers of small magnitude, such as
+// temperature readings, where the differences are not meaningful.
+package nodelta
+
+import (
+	"errors"
+
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/bp32"
+	"github.com/dataence/encoding/cursor"
+	"github.com/dataence/encoding/fastpfor"
+	"github.com/dataence/encoding/variablebyte"
+)
+
+// NoDelta codec structure: this is not thread-safe (need one per thread)
+type NoDelta struct {
+	codec encoding.Integer
+
+	// Working area
+	buf []int32
+}
+
+var _ encoding.Integer = (*NoDelta)(nil)
+
+// New returns a codec that zigzag encodes the integers before compressing them with codec
+func New(codec encoding.Integer) encoding.Integer {
+	return &NoDelta{
+		codec: codec,
+	}
+}
+
+func NewBP32() encoding.Integer {
+	return New(bp32.New())
+}
+
+func NewFastPFOR() encoding.Integer {
+	return New(fastpfor.New())
+}
+
+func NewVariableByte() encoding.Integer {
+	return New(variablebyte.New())
+}
+
+func (this *NoDelta) Compress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("zigzag/nodelta/Compress: inlength = 0. No work done.")
+	}
+
+	if cap(this.buf) < inlength {
+		this.buf = make([]int32, inlength)
+	}
+	buf := this.buf[:inlength]
+
+	s := inpos.Get()
+	encoding.ZigZag(in[s:s+inlength], buf)
+
+	// The codec may compress fewer integers than given, e.g. if it is block based
+	bufpos := cursor.New()
+	if err := this.codec.Compress(buf, bufpos, inlength, out, outpos); err != nil {
+		return err
+	}
+
+	inpos.Add(bufpos.Get())
+
+	return nil
+}
+
+func (this *NoDelta) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("zigzag/nodelta/Uncompress: inlength = 0. No work done.")
+	}
+
+	s := outpos.Get()
+	if err := this.codec.Uncompress(in, inpos, inlength, out, outpos); err != nil {
+		return err
+	}
+
+	encoding.InverseZigZag(out[s:outpos.Get()], out[s:outpos.Get()])
+
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package nodelta
+
+import (
+	"log"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/dataence/encoding/benchtools"
+	"github.com/dataence/encoding/composition"
+	"github.com/dataence/encoding/cursor"
+)
+
+var (
+	data []int32
+	size int = 128000
+)
+
+// generateReadings returns n temperature-like readings in tenths of a degree
+func generateReadings(n int) []int32 {
+	r := rand.New(rand.NewSource(1))
+	in := make([]int32, n)
+	for i := range in {
+		in[i] = int32(r.NormFloat64() * 150)
+	}
+	return in
+}
+
+func init() {
+	log.Printf("zigzag/nodelta/init: generating %d int32s\n", size)
+	data = generateReadings(size)
+	log.Printf("zigzag/nodelta/init: generated %d integers for test", size)
+}
+
+func TestCodec(t *testing.T) {
+	sizes := []int{128, 128 * 10, 128 * 100, 128 * 1000}
+	benchtools.TestCodec(NewBP32(), data, sizes)
+	benchtools.TestCodec(NewFastPFOR(), data, sizes)
+	benchtools.TestCodec(NewVariableByte(), data, []int{1, 100, 128 * 1000})
+}
+
+func TestComposition(t *testing.T) {
+	sizes := []int{100, 128 * 10, 128*1000 - 1}
+	benchtools.TestCodec(composition.New(NewBP32(), NewVariableByte()), data, sizes)
+	benchtools.TestCodec(composition.New(NewFastPFOR(), NewVariableByte()), data, sizes)
+}
+
+func TestExtremes(t *testing.T) {
+	in := make([]int32, 256)
+	for i := range in {
+		in[i] = int32(i - 128)
+	}
+	in[0], in[1], in[255] = math.MinInt32, math.MaxInt32, -1
+
+	benchtools.TestCodec(NewBP32(), in, []int{len(in)})
+	benchtools.TestCodec(NewFastPFOR(), in, []int{len(in)})
+}
+
+// go test -bench=Decode
+func BenchmarkDecode(b *testing.B) {
+	b.StopTimer()
+	length := 128 * 1024
+	data := generateReadings(length)
+	compdata := make([]int32, 2*length)
+	recov := make([]int32, length)
+	inpos := cursor.New()
+	outpos := cursor.New()
+	codec := NewBP32()
+	codec.Compress(data, inpos, len(data), compdata, outpos)
+	b.StartTimer()
+	for j := 0; j < b.N; j++ {
+		newinpos := cursor.New()
+		newoutpos := cursor.New()
+		codec.Uncompress(compdata, newinpos, outpos.Get()-newinpos.Get(), recov, newoutpos)
+	}
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+// Package variablebyte compresses the differences between successive integers,
+// zigzag encoded so that the negative ones stay small, with variable byte encoding.
+// Each difference is written 7 bits per byte, the lowest ones first, the high bit of
+// a byte being set when more bytes follow. The bytes are packed into int32s, the first
+// one in the most significant byte, and the last int32 is padded with 128s.
+// It is suitable for unsorted integers close to each other, such as sensor readings.
+package variablebyte
+
+import (
+	"errors"
+
+	"github.com/dataence/bytebuffer"
+	"github.com/dataence/encoding"
+	"github.com/dataence/encoding/cursor"
+)
+
+type VariableByte struct {
+}
+
+var _ encoding.Integer = (*VariableByte)(nil)
+
+func New() encoding.Integer {
+	return &VariableByte{}
+}
+
+func (this *VariableByte) Compress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) error {
+	if inlength == 0 {
+		return errors.New("zigzag/variablebyte/Compress: inlength = 0. No work done.")
+	}
+
+	buf := bytebuffer.NewByteBuffer(inlength * 8)
+	initoffset := int32(0)
+
+	tmpinpos := inpos.Get()
+	for _, v := range in[tmpinpos : tmpinpos+inlength] {
+		n := v - initoffset
+		val := uint32((n << 1) ^ (n >> 31))
+		initoffset = v
+
+		for val >= 0x80 {
+			buf.Put(byte(val) | 0x80)
+			val >>= 7
+		}
+		buf.Put(byte(val))
+	}
+
+	for buf.Position()%4 != 0 {
+		buf.Put(128)
+	}
+
+	length := buf.Position()
+	buf.Flip()
+	ibuf := buf.AsInt32Buffer()
+	err := ibuf.GetInt32s(out, outpos.Get(), length/4)
+	if err != nil {
+		return err
+	}
+	outpos.Add(length / 4)
+	inpos.Add(inlength)
+
+	return nil
+}
+
+func (this *VariableByte) Uncompress(in []int32, inpos *cursor.Cursor, inlength int, out []int32, outpos *cursor.Cursor) (err error) {
+	if inlength == 0 {
+		return errors.New("zigzag/variablebyte/Uncompress: inlength = 0. No work done.")
+	}
+
+	defer encoding.Recover("zigzag/variablebyte/Uncompress", &err)
+
+	s := uint(0)
+	p := inpos.Get()
+	finalp := inpos.Get() + inlength
+	tmpoutpos := outpos.Get()
+	initoffset := int32(0)
+	v := int32(0)
+	shift := uint(0)
+
+	for p < finalp {
+		c := in[p] >> (24 - s)
+		s += 8
+
+		if s == 32 {
+			s = 0
+			p += 1
+		}
+
+		v += ((c & 127) << shift)
+		if c&128 == 0 {
+			out[tmpoutpos] = (int32(uint32(v)>>1) ^ ((v << 31) >> 31)) + initoffset
+			initoffset = out[tmpoutpos]
+			tmpoutpos += 1
+			v = 0
+			shift = 0
+		} else {
+			shift += 7
+		}
+	}
+
+	outpos.Set(tmpoutpos)
+	inpos.Add(inlength)
+
+	return nil
+}
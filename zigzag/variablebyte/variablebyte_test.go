@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2013 Zhen, LLC. http://zhen.io. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license.
+ *
+ */
+
+package variablebyte
+
+import (
+	"log"
+	"math"
+	"testing"
+
+	"github.com/dataence/encoding/benchtools"
+	"github.com/dataence/encoding/generators"
+)
+
+var (
+	data []int32
+	size int = 128000
+)
+
+func init() {
+	log.Printf("zigzag/variablebyte/init: generating %d int32s\n", size)
+	data = generators.GenerateClustered(size, size*2)
+	log.Printf("zigzag/variablebyte/init: generated %d integers for test", size)
+}
+
+func TestCodec(t *testing.T) {
+	sizes := []int{1, 100, 128, 128 * 10, 128 * 100, 128 * 1000}
+	benchtools.TestCodec(New(), data, sizes)
+}
+
+func TestNegativeDeltas(t *testing.T) {
+	in := make([]int32, 1000)
+	for i := range in {
+		in[i] = int32(1000 - i*(i%3))
+	}
+	in[10], in[11] = math.MinInt32, math.MaxInt32
+
+	benchtools.TestCodec(New(), in, []int{len(in)})
+
+	// Small negative deltas take a single byte
+	in = make([]int32, 1000)
+	for i := range in {
+		in[i] = int32(-i)
+	}
+
+	_, out, err := benchtools.Compress(New(), in, len(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(out) != len(in)/4 {
+		t.Fatalf("zigzag/variablebyte/TestNegativeDeltas: compressed to %d int32s, expected %d", len(out), len(in)/4)
+	}
+}